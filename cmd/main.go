@@ -1,47 +1,290 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/trustwallet/assets-go-libs/path"
 
 	"github.com/trustwallet/assets/internal/config"
 	"github.com/trustwallet/assets/internal/file"
 	"github.com/trustwallet/assets/internal/processor"
 	"github.com/trustwallet/assets/internal/report"
 	"github.com/trustwallet/assets/internal/service"
+	"github.com/trustwallet/go-primitives/coin"
 )
 
+const graphQLShutdownTimeout = 5 * time.Second
+
 var (
 	configPath, root, script string
+	oldRoot, readmeTemplate  string
+	logoPath, archiveRoot    string
+	backupDir                string
+	chain, newChain          string
+	coinGeckoPlatform        string
+	logoURLTemplate          string
+	listenAddr               string
+	changelogPath            string
+	sitemapBaseURL           string
+	searchQuery              string
+	sitemapPart              int
+	workers                  int
+	similarity, rateLimit    float64
+	maxAge, cacheTTL         time.Duration
+	dryRun, overwrite        bool
+	jsonTabs                 bool
 )
 
 func main() {
 	setup()
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
 	paths, err := file.ReadLocalFileStructure(root, config.Default.ValidatorsSettings.RootFolder.SkipFiles)
 	if err != nil {
 		log.WithError(err).Fatal("Failed to load file structure.")
 	}
 
 	fileService := file.NewService(paths...)
-	validatorsService := processor.NewService(fileService)
+	validatorsService := processor.NewService(fileService,
+		processor.WithRPCEndpoints(rpcEndpoints(config.Default.ClientURLs.RPC)),
+		processor.WithDryRun(dryRun),
+		processor.WithRateLimit(rateLimit),
+		processor.WithGraphQLCacheTTL(cacheTTL),
+		processor.WithIndentStyle(indentStyle()),
+		processor.WithExplorerTemplates(config.Default.URLs.ExplorerTemplates),
+		processor.WithExplorerPrefixes(config.Default.URLs.ExplorerPrefixes),
+		processor.WithLogoURLTemplate(config.Default.URLs.AssetLogoTemplate),
+		processor.WithPlaceholderLogoHashes(config.Default.ValidatorsSettings.LogoFile.PlaceholderHashes),
+		processor.WithReadmeTemplate(readmeTemplate),
+		processor.WithCoinGeckoAPIKey(os.Getenv("COINGECKO_API_KEY")),
+	)
 	reportService := report.NewService()
 	assetfsProcessor := service.NewService(fileService, validatorsService, reportService)
 
 	switch script {
 	case "checker":
-		assetfsProcessor.RunJob(paths, assetfsProcessor.Check)
+		assetfsProcessor.RunJob(ctx, paths, assetfsProcessor.Check)
+
+		if count := validatorsService.LogosWithoutAlpha(); count > 0 {
+			log.WithField("count", count).Warn("Logos have no alpha channel, use trace log level to list them")
+		}
+	case "checker-report":
+		runCheckerReport(ctx, assetfsProcessor)
+
+		return
+	case "links-checker":
+		assetfsProcessor.RunJob(ctx, paths, assetfsProcessor.CheckLinks)
 	case "fixer":
-		assetfsProcessor.RunJob(paths, assetfsProcessor.Fix)
+		assetfsProcessor.RunJob(ctx, paths, assetfsProcessor.Fix)
+	case "watcher":
+		if err = assetfsProcessor.WatchAndFix(ctx, root); err != nil {
+			log.WithError(err).Fatal("Failed to watch files.")
+		}
+	case "fixer-concurrent":
+		fixConcurrent(ctx, assetfsProcessor)
+	case "tokenlist-csv":
+		if err = validatorsService.ExportTokenListCSV(ctx, chain, os.Stdout); err != nil {
+			log.WithError(err).Fatal("Failed to export token list.")
+		}
+	case "assets-csv":
+		if err = validatorsService.ExportAssetInfoCSV(ctx, chain, os.Stdout); err != nil {
+			log.WithError(err).Fatal("Failed to export asset infos.")
+		}
+	case "tokenlist-generate":
+		if err = validatorsService.GenerateChainTokenList(ctx, chain); err != nil {
+			log.WithError(err).Fatal("Failed to generate token list.")
+		}
+	case "tokenlist-repair":
+		if err = validatorsService.RepairTokenListFromAssetDirs(ctx, chain); err != nil {
+			log.WithError(err).Fatal("Failed to repair token list.")
+		}
+	case "tokenlist-coingecko":
+		if err = validatorsService.GenerateTokenListFromCoingecko(ctx, chain, coinGeckoPlatform); err != nil {
+			log.WithError(err).Error("Failed to bootstrap some assets from CoinGecko.")
+			reportService.IncErrors()
+		}
+	case "logo-manifest":
+		if err = validatorsService.GenerateLogoManifest(ctx, chain, os.Stdout); err != nil {
+			log.WithError(err).Fatal("Failed to generate logo manifest.")
+		}
+	case "assets-import":
+		imported, skipped, err := validatorsService.BulkImportAssets(ctx, os.Stdin, chain, overwrite)
+		if err != nil {
+			log.WithError(err).Error("Failed to import some assets.")
+			reportService.IncErrors()
+		}
+
+		log.WithField("imported", imported).WithField("skipped", skipped).Info("Imported assets")
+	case "logos-backfill":
+		backfillLogos(ctx, validatorsService, reportService)
+	case "assets-diff":
+		if err = validatorsService.ExportDiff(ctx, oldRoot, root, os.Stdout); err != nil {
+			log.WithError(err).Fatal("Failed to export assets diff.")
+		}
+	case "tokenlist-version":
+		validateTokenListVersion(ctx, validatorsService, fileService, reportService)
+	case "tokenlist-count":
+		compareTokenCounts(ctx, validatorsService, reportService)
+	case "tokenlist-timestamp":
+		tokenListFile := fileService.GetAssetFile(fmt.Sprintf("./%s", path.GetTokenListPath(chain)))
+		if err = validatorsService.ValidateTokenListTimestamp(ctx, tokenListFile, maxAge); err != nil {
+			log.WithError(err).WithField("chain", chain).Error("Token list timestamp is invalid")
+			reportService.IncErrors()
+		}
+	case "logo-thumbnail":
+		if err = validatorsService.RenderLogoThumbnail(ctx, logoPath, os.Stdout); err != nil {
+			log.WithError(err).Fatal("Failed to render logo thumbnail.")
+		}
+	case "logo-thumbnail-sheet":
+		if err = validatorsService.RenderChainThumbnailSheet(ctx, chain, os.Stdout); err != nil {
+			log.WithError(err).Fatal("Failed to render thumbnail sheet.")
+		}
+	case "logo-manifest-verify":
+		verifyLogoManifest(ctx, validatorsService, reportService)
+	case "fixer-logos":
+		fixAllLogos(ctx, validatorsService, reportService)
+	case "fixer-chain-infos":
+		fixed, err := validatorsService.FixAllChainInfoJSONs(ctx)
+		if err != nil {
+			log.WithError(err).Error("Failed to fix some chain info files.")
+			reportService.IncErrors()
+		}
+
+		log.WithField("fixed", fixed).Info("Fixed chain info files")
+	case "fixer-asset-infos":
+		result, err := validatorsService.FixAssetInfoBatch(ctx, chain)
+		if err != nil {
+			log.WithError(err).Error("Failed to fix asset info files.")
+			reportService.IncErrors()
+		}
+
+		if result != nil {
+			for assetPath, e := range result.Failed {
+				log.WithError(e).WithField("path", assetPath).Error("Failed to fix asset info file")
+			}
+
+			log.WithField("fixed", len(result.Modified)).Info("Fixed asset info files")
+		}
+	case "fixer-tokenlist-logos":
+		if err = validatorsService.FixTokenListLogoURIs(ctx, chain, logoURLTemplate); err != nil {
+			log.WithError(err).Fatal("Failed to fix token list logo urls.")
+		}
+	case "fixer-tokenlist-timestamp":
+		tokenListFile := fileService.GetAssetFile(fmt.Sprintf("./%s", path.GetTokenListPath(chain)))
+		if err = validatorsService.FixTokenListTimestamp(ctx, tokenListFile); err != nil {
+			log.WithError(err).Error("Failed to update token list timestamp.")
+			reportService.IncErrors()
+		}
+	case "fixer-evm-checksums":
+		renamed, err := validatorsService.FixAllEVMChecksums(ctx, chain)
+		if err != nil {
+			log.WithError(err).Error("Failed to fix some checksums.")
+			reportService.IncErrors()
+		}
+
+		log.WithField("renamed", renamed).Info("Fixed EVM checksums")
+	case "assets-archive":
+		archived, err := validatorsService.ArchiveInactiveAssets(ctx, chain, archiveRoot, dryRun)
+		if err != nil {
+			log.WithError(err).Error("Failed to archive some assets.")
+			reportService.IncErrors()
+		}
+
+		log.WithField("archived", archived).Info("Archived inactive assets")
+	case "chain-migrate":
+		if err = validatorsService.MigrateChainAssets(ctx, chain, newChain, backupDir); err != nil {
+			log.WithError(err).Fatal("Failed to migrate chain assets.")
+		}
+	case "chain-consistency":
+		if err = validatorsService.ValidateChainConsistency(ctx, chain); err != nil {
+			log.WithError(err).WithField("chain", chain).Error("Chain info doesn't match the chain coin")
+			reportService.IncErrors()
+		}
+	case "orphaned-assets":
+		logOrphanedAssets(ctx, validatorsService, reportService)
+	case "symlinks":
+		symlinks, err := validatorsService.ValidateNoSymlinkAssets(ctx, filepath.Join(root, "blockchains"))
+		for _, p := range symlinks {
+			log.WithField("path", p).Error("Symbolic link in the assets tree")
+			reportService.IncErrors()
+		}
+
+		if err != nil && !errors.Is(err, processor.ErrSymlink) {
+			log.WithError(err).Fatal("Failed to walk the assets tree.")
+		}
+	case "assets-without-logo":
+		assetPaths, err := validatorsService.FindAssetsWithoutLogo(ctx, chain)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to find assets without logo.")
+		}
+
+		for _, p := range assetPaths {
+			log.WithField("path", p).Info("Asset has no logo")
+		}
+	case "missing-chains":
+		coins, err := validatorsService.ReportMissingChains(ctx)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to find missing chains.")
+		}
+
+		for _, c := range coins {
+			log.WithField("handle", c.Handle).WithField("id", c.ID).Info("Coin has no chain folder")
+		}
+	case "tokenlist-missing-assets":
+		tokens, err := validatorsService.CrossValidateTokenListVsAssetDirs(ctx, chain)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to cross validate token list.")
+		}
+
+		for _, token := range tokens {
+			log.WithField("address", token.Address).WithField("symbol", token.Symbol).
+				Warn("Token list entry has no asset folder")
+		}
+	case "phishing-assets":
+		logPhishingAssets(ctx, validatorsService)
+	case "address-duplicates":
+		logAddressConflicts(ctx, validatorsService)
+	case "cross-chain-consistency":
+		logCrossChainConflicts(ctx, validatorsService)
+	case "social-duplicates":
+		logSocialConflicts(ctx, validatorsService)
+	case "asset-sitemap":
+		generateAssetSitemap(ctx, validatorsService)
+	case "chain-readme":
+		if err = validatorsService.GenerateReadmeForChain(ctx, chain, os.Stdout); err != nil {
+			log.WithError(err).Fatal("Failed to generate chain readme.")
+		}
+	case "chain-stats":
+		printChainStats(ctx, validatorsService)
+	case "assets-status-count":
+		printStatusCounts(ctx, validatorsService)
+	case "assets-search":
+		searchAssets(ctx, validatorsService)
+	case "graphql-server":
+		serveGraphQL(ctx, validatorsService)
 	case "updater-auto":
-		assetfsProcessor.RunUpdateAuto()
+		assetfsProcessor.RunUpdateAuto(ctx)
 	case "updater-manual":
-		assetfsProcessor.RunUpdateManual()
+		assetfsProcessor.RunUpdateManual(ctx)
 	default:
 		log.Info("Nothing to launch. Use --script flag to choose a script to run.")
 	}
 
+	stop()
+
 	reportMsg := reportService.GetReport()
 
 	if reportService.IsFailed() {
@@ -54,7 +297,38 @@ func main() {
 func setup() {
 	flag.StringVar(&configPath, "config", "./.github/assets.config.yaml", "path to config file")
 	flag.StringVar(&root, "root", "./", "path to the root of the dir")
+	flag.StringVar(&oldRoot, "old-root", "",
+		"path to the root of the dir to compare with for assets-diff, tokenlist-version and tokenlist-count scripts")
 	flag.StringVar(&script, "script", "", "script type to run")
+	flag.StringVar(&chain, "chain", "", "chain handle for chain specific scripts, e.g. ethereum")
+	flag.StringVar(&newChain, "new-chain", "", "new chain handle for chain-migrate script")
+	flag.StringVar(&coinGeckoPlatform, "coingecko-platform", "",
+		"CoinGecko platform ID for tokenlist-coingecko script, e.g. binance-smart-chain")
+	flag.StringVar(&logoURLTemplate, "logo-url-template",
+		"https://assets.trustwalletapp.com/blockchains/%s/assets/%s/logo.png",
+		"logo url template for fixer-tokenlist-logos script, chain handle and address replace the %s verbs")
+	flag.StringVar(&sitemapBaseURL, "sitemap-base-url", "", "base url of asset pages for asset-sitemap script")
+	flag.IntVar(&sitemapPart, "sitemap-part", 0, "part of the split sitemap for asset-sitemap script, 0 for the index")
+	flag.StringVar(&searchQuery, "query", "", "words to find in asset names, symbols and ids for assets-search script")
+	flag.StringVar(&changelogPath, "changelog", "",
+		"path to a markdown file to append fixes applied by fixer-concurrent script")
+	flag.StringVar(&listenAddr, "listen", ":8080", "address of the graphql-server script")
+	flag.DurationVar(&cacheTTL, "cache-ttl", time.Minute, "how long graphql-server script caches files, 0 disables cache")
+	flag.IntVar(&workers, "workers", 0, "number of concurrent fixer workers, defaults to the number of CPUs")
+	flag.DurationVar(&maxAge, "max-age", 90*24*time.Hour,
+		"age of token list timestamp to warn about in tokenlist-timestamp script")
+	flag.Float64Var(&rateLimit, "rate-limit", 0, "maximum requests per second to external APIs, 0 for no limit")
+	flag.Float64Var(&similarity, "similarity", 0.8, "minimum similarity to top tokens for phishing-assets script")
+	flag.StringVar(&readmeTemplate, "readme-template", "", "path to a custom template for chain-readme script")
+	flag.StringVar(&logoPath, "logo", "", "path to a logo for logo-thumbnail script")
+	flag.StringVar(&backupDir, "backup-dir", "",
+		"path to the dir for files modified by chain-migrate script, defaults to a new temp dir")
+	flag.StringVar(&archiveRoot, "archive-root", "../assets-archive",
+		"path to the archive dir for assets-archive script, outside of the repo root")
+	flag.BoolVar(&dryRun, "dry-run", false, "log changes of fixers without writing files")
+	flag.BoolVar(&jsonTabs, "json-tabs", false,
+		"indent json files written by fixers and generators with tabs instead of 4 spaces")
+	flag.BoolVar(&overwrite, "overwrite", false, "overwrite existing asset info files in assets-import script")
 
 	flag.Parse()
 
@@ -69,3 +343,293 @@ func setup() {
 
 	log.SetLevel(logLevel)
 }
+
+func indentStyle() processor.IndentStyle {
+	if jsonTabs {
+		return processor.IndentTab
+	}
+
+	return processor.IndentFourSpaces
+}
+
+func rpcEndpoints(urls map[string]string) map[uint]string {
+	endpoints := make(map[uint]string, len(urls))
+
+	for handle, url := range urls {
+		c, err := coin.GetCoinForId(handle)
+		if err != nil {
+			log.WithError(err).Fatal("Unknown chain in rpc config.")
+		}
+
+		endpoints[c.ID] = url
+	}
+
+	return endpoints
+}
+
+func runCheckerReport(ctx context.Context, s *service.Service) {
+	validationReport, err := s.ValidateAll(ctx)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to validate files.")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "    ")
+
+	if err = encoder.Encode(validationReport); err != nil {
+		log.WithError(err).Fatal("Failed to encode report.")
+	}
+
+	if len(validationReport.Errors) > 0 {
+		log.Fatal(validationReport.Summary())
+	}
+
+	log.Info(validationReport.Summary())
+}
+
+func verifyLogoManifest(ctx context.Context, s *processor.Service, rs *report.Service) {
+	mismatches, err := s.VerifyLogoManifest(ctx, os.Stdin)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to verify logo manifest.")
+	}
+
+	for _, m := range mismatches {
+		log.WithFields(log.Fields{
+			"path":     m.Path,
+			"expected": m.Expected,
+			"actual":   m.Actual,
+		}).Error("Logo differs from manifest")
+
+		rs.IncErrors()
+	}
+}
+
+func fixConcurrent(ctx context.Context, s *service.Service) {
+	var changelog io.Writer
+	if changelogPath != "" {
+		changelogFile, err := os.OpenFile(changelogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to open changelog.")
+		}
+		defer changelogFile.Close()
+
+		changelog = changelogFile
+	}
+
+	if err := s.FixAllConcurrent(ctx, workers, changelog); err != nil {
+		log.WithError(err).Error("Fixing is interrupted.")
+	}
+}
+
+func printChainStats(ctx context.Context, s *processor.Service) {
+	stats, err := s.ChainStats(ctx, chain)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to collect chain stats.")
+	}
+
+	if err = json.NewEncoder(os.Stdout).Encode(stats); err != nil {
+		log.WithError(err).Fatal("Failed to encode chain stats.")
+	}
+}
+
+func printStatusCounts(ctx context.Context, s *processor.Service) {
+	counts, err := s.CountAssetsByStatus(ctx, chain)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to count assets by status.")
+	}
+
+	if err = json.NewEncoder(os.Stdout).Encode(counts); err != nil {
+		log.WithError(err).Fatal("Failed to encode status counts.")
+	}
+}
+
+func searchAssets(ctx context.Context, s *processor.Service) {
+	index, err := s.GenerateAssetIndex(ctx)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to build asset index.")
+	}
+
+	if err = json.NewEncoder(os.Stdout).Encode(index.Search(searchQuery)); err != nil {
+		log.WithError(err).Fatal("Failed to encode found assets.")
+	}
+}
+
+func logSocialConflicts(ctx context.Context, s *processor.Service) {
+	conflicts, err := s.ValidateDuplicateSocialLinks(ctx)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to find duplicate social links.")
+	}
+
+	for _, c := range conflicts {
+		log.WithField("url", c.URL).WithField("assets", c.Assets).Warn("Social link is used by several assets")
+	}
+}
+
+func validateTokenListVersion(ctx context.Context, s *processor.Service, fs *file.Service, rs *report.Service) {
+	tokenListPath := path.GetTokenListPath(chain)
+
+	baseline, err := os.Open(filepath.Join(oldRoot, tokenListPath))
+	if err != nil {
+		log.WithError(err).Fatal("Failed to open baseline token list.")
+	}
+	defer baseline.Close()
+
+	if err = s.ValidateTokenListVersion(ctx, fs.GetAssetFile(tokenListPath), baseline); err != nil {
+		log.WithError(err).WithField("chain", chain).Error("Token list version is not bumped")
+		rs.IncErrors()
+	}
+}
+
+func serveGraphQL(ctx context.Context, s *processor.Service) {
+	server := &http.Server{Addr: listenAddr, Handler: s.GraphQLHandler()}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), graphQLShutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.WithError(err).Error("Failed to shut down graphql server.")
+		}
+	}()
+
+	log.WithField("addr", listenAddr).Info("Serving graphql")
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.WithError(err).Fatal("Failed to serve graphql.")
+	}
+}
+
+func compareTokenCounts(ctx context.Context, s *processor.Service, rs *report.Service) {
+	baseline, err := os.Open(filepath.Join(oldRoot, path.GetTokenListPath(chain)))
+	if err != nil {
+		log.WithError(err).Fatal("Failed to open baseline token list.")
+	}
+	defer baseline.Close()
+
+	delta, err := s.CompareChainTokenCounts(ctx, chain, baseline)
+	if err != nil {
+		log.WithError(err).WithField("chain", chain).Error("Token list lost too many tokens")
+		rs.IncErrors()
+
+		return
+	}
+
+	log.WithField("chain", chain).WithField("delta", delta).Info("Compared token list size with baseline")
+}
+
+func generateAssetSitemap(ctx context.Context, s *processor.Service) {
+	if sitemapPart == 0 {
+		if err := s.GenerateAssetSitemap(ctx, sitemapBaseURL, os.Stdout); err != nil {
+			log.WithError(err).Fatal("Failed to generate asset sitemap.")
+		}
+
+		return
+	}
+
+	if _, err := s.GenerateAssetSitemapPart(ctx, sitemapBaseURL, sitemapPart, os.Stdout); err != nil {
+		log.WithError(err).Fatal("Failed to generate asset sitemap part.")
+	}
+}
+
+func backfillLogos(ctx context.Context, s *processor.Service, rs *report.Service) {
+	var urlMap map[string]string
+	if err := json.NewDecoder(os.Stdin).Decode(&urlMap); err != nil {
+		log.WithError(err).Fatal("Failed to decode logo urls.")
+	}
+
+	downloaded, err := s.BackfillLogoFromURL(ctx, urlMap)
+	if err != nil {
+		log.WithError(err).Error("Failed to download some logos.")
+		rs.IncErrors()
+	}
+
+	log.WithField("downloaded", downloaded).Info("Downloaded missing logos")
+}
+
+func logPhishingAssets(ctx context.Context, s *processor.Service) {
+	candidates, err := s.DetectPhishingAssets(ctx, similarity)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to detect phishing assets.")
+	}
+
+	for _, c := range candidates {
+		log.WithField("path", c.AssetPath).
+			WithField("similarTo", c.SimilarTo).
+			WithField("similarity", c.Similarity).
+			Warn("Asset is similar to a top token")
+	}
+}
+
+func logAddressConflicts(ctx context.Context, s *processor.Service) {
+	conflicts, err := s.ValidateNoDuplicateAddresses(ctx)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to find duplicate addresses.")
+	}
+
+	for _, c := range conflicts {
+		log.WithField("address", c.Address).WithField("chains", c.Chains).Warn("Address is used on several chains")
+	}
+}
+
+func logCrossChainConflicts(ctx context.Context, s *processor.Service) {
+	conflicts, err := s.ValidateCrossChainConsistency(ctx)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to compare assets across chains.")
+	}
+
+	for _, c := range conflicts {
+		for _, asset := range c.Assets {
+			log.WithField("address", c.Address).
+				WithField("chain", asset.Chain).
+				WithField("name", asset.Name).
+				WithField("symbol", asset.Symbol).
+				Warn("Address has different names or symbols across chains")
+		}
+	}
+}
+
+func fixAllLogos(ctx context.Context, s *processor.Service, rs *report.Service) {
+	progress := make(chan processor.FixProgress)
+
+	var err error
+	go func() {
+		defer close(progress)
+
+		err = s.FixAllLogos(ctx, workers, progress)
+	}()
+
+	for p := range progress {
+		entry := log.WithFields(log.Fields{"done": p.Done, "total": p.Total, "path": p.LastPath})
+		if p.Err != nil {
+			entry.WithError(p.Err).Error("Failed to fix logo")
+			rs.IncErrors()
+
+			continue
+		}
+
+		entry.Debug("Fixed logo")
+	}
+
+	// The error is set before progress is closed.
+	switch {
+	case err != nil && ctx.Err() != nil:
+		log.WithError(err).Warn("Logo fixing is interrupted.")
+	case err != nil:
+		log.WithError(err).Error("Failed to fix logos.")
+		rs.IncErrors()
+	}
+}
+
+func logOrphanedAssets(ctx context.Context, s *processor.Service, rs *report.Service) {
+	orphaned, err := s.ValidateNoOrphanedAssetDirectories(ctx, chain)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to find orphaned asset folders.")
+	}
+
+	for _, p := range orphaned {
+		log.WithField("path", p).Error("Asset folder has no info.json")
+		rs.IncErrors()
+	}
+}
@@ -3,15 +3,24 @@ module github.com/trustwallet/assets
 go 1.17
 
 require (
+	github.com/btcsuite/btcutil v1.0.2
+	github.com/fsnotify/fsnotify v1.5.1
+	github.com/graphql-go/graphql v0.8.0
+	github.com/mr-tron/base58 v1.2.0
+	github.com/santhosh-tekuri/jsonschema/v5 v5.2.0
 	github.com/sirupsen/logrus v1.8.1
+	github.com/srwiley/oksvg v0.0.0-20221011165216-be6e8873101c
+	github.com/srwiley/rasterx v0.0.0-20220730225603-2ab79fcdd4ef
 	github.com/trustwallet/assets-go-libs v0.0.19
 	github.com/trustwallet/go-libs v0.2.21-0.20211217144209-59d4828f9793
 	github.com/trustwallet/go-primitives v0.0.19
+	golang.org/x/image v0.0.0-20211028202545-6944b10bf410
+	golang.org/x/time v0.0.0-20211116232009-f0f3c7e86c11
 )
 
 require (
+	github.com/btcsuite/btcd v0.20.1-beta // indirect
 	github.com/deckarep/golang-set v1.7.1 // indirect
-	github.com/fsnotify/fsnotify v1.5.1 // indirect
 	github.com/hashicorp/hcl v1.0.0 // indirect
 	github.com/magiconair/properties v1.8.5 // indirect
 	github.com/mitchellh/mapstructure v1.4.3 // indirect
@@ -25,9 +34,10 @@ require (
 	github.com/spf13/viper v1.10.0 // indirect
 	github.com/subosito/gotenv v1.2.0 // indirect
 	golang.org/x/crypto v0.0.0-20211209193657-4570a0811e8b // indirect
-	golang.org/x/image v0.0.0-20211028202545-6944b10bf410 // indirect
+	golang.org/x/net v0.0.0-20211118161319-6a13c67c3ce4 // indirect
 	golang.org/x/sys v0.0.0-20211213223007-03aa0b5f6827 // indirect
 	golang.org/x/text v0.3.7 // indirect
 	gopkg.in/ini.v1 v1.66.2 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
+
@@ -24,11 +24,16 @@ type (
 			Dex      string `mapstructure:"dex"`
 			Explorer string `mapstructure:"explorer"`
 		} `mapstructure:"binance"`
-		BackendAPI string `mapstructure:"backend_api"`
+		BackendAPI string            `mapstructure:"backend_api"`
+		CoinGecko  string            `mapstructure:"coingecko"`
+		RPC        map[string]string `mapstructure:"rpc"`
 	}
 
 	URLs struct {
-		TWAssetsApp string `mapstructure:"tw_assets_app"`
+		TWAssetsApp       string              `mapstructure:"tw_assets_app"`
+		ExplorerTemplates map[string]string   `mapstructure:"explorer_templates"`
+		ExplorerPrefixes  map[string][]string `mapstructure:"explorer_prefixes"`
+		AssetLogoTemplate string              `mapstructure:"asset_logo_template"`
 	}
 
 	ValidatorsSettings struct {
@@ -39,6 +44,9 @@ type (
 		ChainValidatorsAssetFolder ChainValidatorsAssetFolder `mapstructure:"chain_validators_asset_folder"`
 		DappsFolder                DappsFolder                `mapstructure:"dapps_folder"`
 		CoinInfoFile               CoinInfoFile               `mapstructure:"coin_info_file"`
+		TokenListFile              TokenListFile              `mapstructure:"token_list_file"`
+		LogoFile                   LogoFile                   `mapstructure:"logo_file"`
+		AssetAddresses             AssetAddresses             `mapstructure:"asset_addresses"`
 	}
 
 	TradingPairSettings struct {
@@ -34,3 +34,18 @@ type Tag struct {
 	Name        string `mapstructure:"name,omitempty"`
 	Description string `mapstructure:"description,omitempty"`
 }
+
+type LogoFile struct {
+	RequireTransparency  bool     `mapstructure:"require_transparency"`
+	MinLuminanceVariance float64  `mapstructure:"min_luminance_variance"`
+	PlaceholderHashes    []string `mapstructure:"placeholder_hashes,omitempty"`
+}
+
+type AssetAddresses struct {
+	SharedAddressChains []string `mapstructure:"shared_address_chains,omitempty"`
+}
+
+type TokenListFile struct {
+	MaxTokens      map[string]int `mapstructure:"max_tokens,omitempty"`
+	MaxDropPercent float64        `mapstructure:"max_drop_percent"`
+}
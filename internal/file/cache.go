@@ -2,6 +2,7 @@ package file
 
 import (
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -26,16 +27,30 @@ func NewService(filePaths ...string) *Service {
 }
 
 func (f *Service) GetAssetFile(path string) *AssetFile {
-	f.mu.RLock()
-	defer f.mu.RUnlock()
+	f.mu.Lock()
+	defer f.mu.Unlock()
 
 	return f.getFile(path)
 }
 
-func (f *Service) UpdateFile(file *AssetFile, newFileBaseName string) {
+func (f *Service) GetPaths() []string {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
 
+	paths := make([]string, 0, len(f.cache))
+	for path := range f.cache {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+func (f *Service) UpdateFile(file *AssetFile, newFileBaseName string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
 	oldFileBaseName := filepath.Base(file.Path())
 
 	for path := range f.cache {
@@ -1,9 +1,16 @@
 package file
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
 	"github.com/trustwallet/go-primitives/coin"
 )
 
+const tokenListFileName = "tokenlist.json"
+
 type AssetFile struct {
 	path *Path
 }
@@ -27,3 +34,28 @@ func (i *AssetFile) Chain() coin.Coin {
 func (i *AssetFile) Asset() string {
 	return i.path.asset
 }
+
+// ChainTokenCount returns the number of tokens in tokenlist.json of the chain folder, zero if the chain has
+// no token list.
+func (i *AssetFile) ChainTokenCount() (int, error) {
+	if i.Type() != TypeChainFolder {
+		return 0, fmt.Errorf("%s is not a chain folder", i.Path())
+	}
+
+	data, err := os.ReadFile(filepath.Join(i.Path(), tokenListFileName))
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	var tokenList struct {
+		Tokens []json.RawMessage `json:"tokens"`
+	}
+
+	if err = json.Unmarshal(data, &tokenList); err != nil {
+		return 0, fmt.Errorf("failed to decode token list: %w", err)
+	}
+
+	return len(tokenList.Tokens), nil
+}
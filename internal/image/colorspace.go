@@ -0,0 +1,183 @@
+package image
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// pngGammaSRGB is the gAMA value of sRGB: gamma 1/2.2 multiplied by 100000.
+	pngGammaSRGB = 45455
+	// pngChromaTolerance is an allowed difference of gAMA and cHRM values, in 1/100000 units.
+	pngChromaTolerance = 1000
+
+	pngChunkHeaderSize = 8
+	pngChunkCRCSize    = 4
+	// pngMaxColorChunkSize limits chunks which are read into memory, cHRM is the largest with 32 bytes.
+	pngMaxColorChunkSize = 32
+)
+
+var (
+	ErrNotSRGB        = errors.New("image is not in sRGB colorspace")
+	ErrNoTransparency = errors.New("image has no transparency")
+)
+
+// sRGBChromaticities are white point, red, green and blue x/y chromaticities of sRGB, multiplied by 100000.
+var sRGBChromaticities = [8]uint32{31270, 32900, 64000, 33000, 30000, 60000, 15000, 6000}
+
+// CheckSRGB checks colorspace chunks of PNG image, without decoding the image data. Images with an sRGB chunk
+// or without any colorspace chunks are considered sRGB, otherwise gAMA and cHRM values have to match sRGB.
+func CheckSRGB(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+
+	signature := make([]byte, len(pngSignature))
+	if _, err = io.ReadFull(r, signature); err != nil || !bytes.Equal(signature, pngSignature) {
+		return fmt.Errorf("%w: not a png image", ErrUnsupportedFormat)
+	}
+
+	chunks, err := readColorChunks(r)
+	if err != nil {
+		return err
+	}
+
+	// sRGB chunk overrides gAMA and cHRM chunks.
+	if _, ok := chunks["sRGB"]; ok {
+		return nil
+	}
+
+	for _, chunkType := range []string{"gAMA", "cHRM"} {
+		if data, ok := chunks[chunkType]; ok {
+			if err = checkColorChunk(chunkType, data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// readColorChunks returns data of sRGB, gAMA and cHRM chunks keyed by chunk type.
+func readColorChunks(r *bufio.Reader) (map[string][]byte, error) {
+	chunks := make(map[string][]byte)
+	header := make([]byte, pngChunkHeaderSize)
+
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil, fmt.Errorf("failed to read png chunk: %w", err)
+		}
+
+		length := binary.BigEndian.Uint32(header[:4])
+		chunkType := string(header[4:])
+
+		// Colorspace chunks have to precede image data.
+		if chunkType == "IDAT" || chunkType == "IEND" {
+			return chunks, nil
+		}
+
+		if chunkType != "sRGB" && chunkType != "gAMA" && chunkType != "cHRM" || length > pngMaxColorChunkSize {
+			if _, err := r.Discard(int(length) + pngChunkCRCSize); err != nil {
+				return nil, fmt.Errorf("failed to read png chunk: %w", err)
+			}
+
+			continue
+		}
+
+		data := make([]byte, length+pngChunkCRCSize)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, fmt.Errorf("failed to read png chunk: %w", err)
+		}
+
+		chunks[chunkType] = data[:length]
+	}
+}
+
+func checkColorChunk(chunkType string, data []byte) error {
+	switch chunkType {
+	case "gAMA":
+		if len(data) != 4 {
+			return fmt.Errorf("%w: invalid gAMA chunk", ErrNotSRGB)
+		}
+
+		if gamma := binary.BigEndian.Uint32(data); !isClose(gamma, pngGammaSRGB) {
+			return fmt.Errorf("%w: gamma %.5f, expected %.5f", ErrNotSRGB,
+				float64(gamma)/100000, float64(pngGammaSRGB)/100000)
+		}
+	case "cHRM":
+		if len(data) != len(sRGBChromaticities)*4 {
+			return fmt.Errorf("%w: invalid cHRM chunk", ErrNotSRGB)
+		}
+
+		for i, expected := range sRGBChromaticities {
+			if !isClose(binary.BigEndian.Uint32(data[i*4:]), expected) {
+				return fmt.Errorf("%w: chromaticities differ from sRGB primaries", ErrNotSRGB)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isClose(value, expected uint32) bool {
+	if value > expected {
+		return value-expected <= pngChromaTolerance
+	}
+
+	return expected-value <= pngChromaTolerance
+}
+
+// HasTransparency checks whether PNG image has an alpha channel (gray or truecolor with alpha color types)
+// or a tRNS chunk with transparent colors, without decoding the image data.
+func HasTransparency(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+
+	header := make([]byte, pngColorTypeOffset+1)
+	if _, err = io.ReadFull(r, header); err != nil || !bytes.HasPrefix(header, pngSignature) {
+		return false, fmt.Errorf("%w: not a png image", ErrUnsupportedFormat)
+	}
+
+	switch header[pngColorTypeOffset] {
+	case pngColorTypeGrayAlpha, pngColorTypeRGBA:
+		return true, nil
+	}
+
+	// Skip the rest of IHDR chunk: compression, filter and interlace methods, and CRC.
+	if _, err = r.Discard(3 + pngChunkCRCSize); err != nil {
+		return false, fmt.Errorf("failed to read png chunk: %w", err)
+	}
+
+	chunkHeader := make([]byte, pngChunkHeaderSize)
+	for {
+		if _, err = io.ReadFull(r, chunkHeader); err != nil {
+			return false, fmt.Errorf("failed to read png chunk: %w", err)
+		}
+
+		switch string(chunkHeader[4:]) {
+		case "tRNS":
+			return true, nil
+		case "IDAT", "IEND":
+			// Transparency chunk has to precede image data.
+			return false, nil
+		}
+
+		if _, err = r.Discard(int(binary.BigEndian.Uint32(chunkHeader[:4])) + pngChunkCRCSize); err != nil {
+			return false, fmt.Errorf("failed to read png chunk: %w", err)
+		}
+	}
+}
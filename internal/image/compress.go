@@ -0,0 +1,75 @@
+package image
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+)
+
+// quantizationBits lists bits per color channel tried after lossless re-encoding, from the least lossy.
+var quantizationBits = []uint{7, 6, 5, 4}
+
+var ErrCompressionFailed = errors.New("failed to compress image")
+
+// CompressPNG re-encodes PNG image until fits returns no error. Image is firstly re-encoded losslessly with
+// the best compression level, then color channels are quantized progressively. Alpha channel is kept as is.
+func CompressPNG(path string, fits func(data []byte) error) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to decode png image: %w", err)
+	}
+
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+
+	candidates := make([]image.Image, 0, len(quantizationBits)+1)
+	candidates = append(candidates, img)
+	for _, bits := range quantizationBits {
+		candidates = append(candidates, quantize(img, bits))
+	}
+
+	for _, candidate := range candidates {
+		var buf bytes.Buffer
+		if err = encoder.Encode(&buf, candidate); err != nil {
+			return fmt.Errorf("failed to encode image: %w", err)
+		}
+
+		if buf.Len() >= len(data) {
+			continue
+		}
+
+		if fits(buf.Bytes()) == nil {
+			if err = os.WriteFile(path, buf.Bytes(), fileModeReadWrite); err != nil {
+				return fmt.Errorf("failed to write file: %w", err)
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrCompressionFailed, path)
+}
+
+// quantize drops lower bits of color channels, so encoder can compress repeated pixels better.
+func quantize(img image.Image, bits uint) *image.NRGBA {
+	bounds := img.Bounds()
+	result := image.NewNRGBA(bounds)
+	mask := uint8(0xff << (8 - bits))
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			result.SetNRGBA(x, y, color.NRGBA{R: c.R & mask, G: c.G & mask, B: c.B & mask, A: c.A})
+		}
+	}
+
+	return result
+}
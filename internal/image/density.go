@@ -0,0 +1,79 @@
+package image
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// pngPhysChunkSize is the size of pHYs chunk: pixels per unit on x and y axes (4 bytes each) and unit (1 byte).
+	pngPhysChunkSize = 9
+	pngPhysUnitMeter = 1
+
+	inchesPerMeter = 39.3700787
+)
+
+var ErrInvalidPixelDensity = errors.New("invalid pixel density")
+
+// GetPixelDensity returns x and y resolutions declared by pHYs chunk of PNG image in DPI, without decoding
+// the image data. ok is false when there is no pHYs chunk or its unit is unknown, i.e. it only sets aspect ratio.
+func GetPixelDensity(path string) (dpiX, dpiY float64, ok bool, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+
+	signature := make([]byte, len(pngSignature))
+	if _, err = io.ReadFull(r, signature); err != nil || !bytes.Equal(signature, pngSignature) {
+		return 0, 0, false, fmt.Errorf("%w: not a png image", ErrUnsupportedFormat)
+	}
+
+	header := make([]byte, pngChunkHeaderSize)
+	for {
+		if _, err = io.ReadFull(r, header); err != nil {
+			return 0, 0, false, fmt.Errorf("failed to read png chunk: %w", err)
+		}
+
+		length := binary.BigEndian.Uint32(header[:4])
+		chunkType := string(header[4:])
+
+		// pHYs chunk has to precede image data.
+		if chunkType == "IDAT" || chunkType == "IEND" {
+			return 0, 0, false, nil
+		}
+
+		if chunkType != "pHYs" {
+			if _, err = r.Discard(int(length) + pngChunkCRCSize); err != nil {
+				return 0, 0, false, fmt.Errorf("failed to read png chunk: %w", err)
+			}
+
+			continue
+		}
+
+		if length != pngPhysChunkSize {
+			return 0, 0, false, fmt.Errorf("%w: invalid pHYs chunk", ErrUnsupportedFormat)
+		}
+
+		data := make([]byte, pngPhysChunkSize)
+		if _, err = io.ReadFull(r, data); err != nil {
+			return 0, 0, false, fmt.Errorf("failed to read png chunk: %w", err)
+		}
+
+		if data[8] != pngPhysUnitMeter {
+			return 0, 0, false, nil
+		}
+
+		dpiX = float64(binary.BigEndian.Uint32(data[:4])) / inchesPerMeter
+		dpiY = float64(binary.BigEndian.Uint32(data[4:8])) / inchesPerMeter
+
+		return dpiX, dpiY, true, nil
+	}
+}
@@ -0,0 +1,242 @@
+package image
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+
+	"golang.org/x/image/webp"
+)
+
+const (
+	FormatPNG     = "png"
+	FormatJPEG    = "jpeg"
+	FormatWebP    = "webp"
+	FormatAVIF    = "avif"
+	FormatSVG     = "svg"
+	FormatUnknown = "unknown"
+
+	ColorModeIndexed = "indexed"
+	ColorModeRGBA    = "rgba"
+)
+
+const fileModeReadWrite = 0600
+
+const (
+	// pngColorTypeOffset is the offset of color type byte: signature (8), chunk length and type (8),
+	// width and height (8), bit depth (1).
+	pngColorTypeOffset    = 25
+	pngColorTypePaletted  = 3
+	pngColorTypeGrayAlpha = 4
+	pngColorTypeRGBA      = 6
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrRGBATooLarge      = errors.New("rgba image is too large")
+	ErrNotPNG            = errors.New("file is not a png image")
+)
+
+var (
+	pngSignature  = []byte("\x89PNG\r\n\x1a\n")
+	jpegSignature = []byte("\xff\xd8\xff")
+	riffSignature = []byte("RIFF")
+	webpSignature = []byte("WEBP")
+	ftypBox       = []byte("ftyp")
+	ispeBox       = []byte("ispe")
+	avifBrands    = [][]byte{[]byte("avif"), []byte("avis")}
+)
+
+// DetectFormat detects an image format by the magic bytes of its content.
+func DetectFormat(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, pngSignature):
+		return FormatPNG
+	case bytes.HasPrefix(data, jpegSignature):
+		return FormatJPEG
+	case len(data) >= 12 && bytes.Equal(data[0:4], riffSignature) && bytes.Equal(data[8:12], webpSignature):
+		return FormatWebP
+	case len(data) >= 12 && bytes.Equal(data[4:8], ftypBox):
+		for _, brand := range avifBrands {
+			if bytes.Equal(data[8:12], brand) {
+				return FormatAVIF
+			}
+		}
+	case isSVG(data):
+		return FormatSVG
+	}
+
+	return FormatUnknown
+}
+
+// GetImageDimensions returns dimensions and format of PNG, JPEG, WebP, AVIF or SVG image.
+// Dimensions of SVG image are the size of its view box.
+func GetImageDimensions(path string) (width, height int, format string, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	format = DetectFormat(data)
+
+	var config image.Config
+	switch format {
+	case FormatPNG:
+		config, err = png.DecodeConfig(bytes.NewReader(data))
+	case FormatJPEG:
+		config, err = jpeg.DecodeConfig(bytes.NewReader(data))
+	case FormatWebP:
+		config, err = webp.DecodeConfig(bytes.NewReader(data))
+	case FormatAVIF:
+		config, err = decodeAVIFConfig(data)
+	case FormatSVG:
+		config, err = decodeSVGConfig(data)
+	default:
+		return 0, 0, format, fmt.Errorf("%w: %s", ErrUnsupportedFormat, path)
+	}
+
+	if err != nil {
+		return 0, 0, format, fmt.Errorf("failed to decode %s config: %w", format, err)
+	}
+
+	return config.Width, config.Height, format, nil
+}
+
+// CheckPNGSignature checks that the file starts with PNG signature, without decoding the image. The error
+// describes the content type sniffed from the file header otherwise.
+func CheckPNGSignature(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	// DetectContentType considers at most 512 bytes.
+	header := make([]byte, 512)
+	n, err := io.ReadFull(file, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	header = header[:n]
+	if bytes.HasPrefix(header, pngSignature) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: content type is %s", ErrNotPNG, http.DetectContentType(header))
+}
+
+// ConvertToPNG rewrites an image file in PNG format. PNG files are left untouched.
+// SVG images are rendered to a square of 256 pixels.
+func ConvertToPNG(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var img image.Image
+	switch format := DetectFormat(data); format {
+	case FormatPNG:
+		return nil
+	case FormatWebP:
+		img, err = webp.Decode(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("failed to decode webp image: %w", err)
+		}
+	case FormatJPEG:
+		img, err = jpeg.Decode(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("failed to decode jpeg image: %w", err)
+		}
+	case FormatSVG:
+		if img, err = rasterizeSVG(data); err != nil {
+			return err
+		}
+	default:
+		// There is no pure Go AVIF decoder, so such logos have to be converted manually.
+		return fmt.Errorf("%w: can't convert %s to png", ErrUnsupportedFormat, format)
+	}
+
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, img); err != nil {
+		return fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	if err = os.WriteFile(path, buf.Bytes(), fileModeReadWrite); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
+
+// decodeAVIFConfig reads dimensions from the "ispe" (image spatial extents) property box.
+func decodeAVIFConfig(data []byte) (image.Config, error) {
+	i := bytes.Index(data, ispeBox)
+	// Box type is followed by version/flags (4 bytes), width (4 bytes) and height (4 bytes).
+	if i < 0 || len(data) < i+16 {
+		return image.Config{}, errors.New("missing ispe box")
+	}
+
+	return image.Config{
+		Width:  int(binary.BigEndian.Uint32(data[i+8 : i+12])),
+		Height: int(binary.BigEndian.Uint32(data[i+12 : i+16])),
+	}, nil
+}
+
+// IsIndexedPNG checks whether PNG image uses a palette, the color type in the IHDR chunk.
+// Images of other formats are not indexed.
+func IsIndexedPNG(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	header := make([]byte, pngColorTypeOffset+1)
+	if _, err = io.ReadFull(file, header); err != nil {
+		return false, nil
+	}
+
+	return bytes.HasPrefix(header, pngSignature) && header[pngColorTypeOffset] == pngColorTypePaletted, nil
+}
+
+// ConvertToRGBA rewrites PNG image with full RGBA colors, e.g. to expand a palette. The file is left untouched
+// with ErrRGBATooLarge when fits returns an error for the encoded image.
+func ConvertToRGBA(path string, fits func(data []byte) error) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to decode png image: %w", err)
+	}
+
+	rgba := image.NewNRGBA(img.Bounds())
+	draw.Draw(rgba, rgba.Bounds(), img, img.Bounds().Min, draw.Src)
+
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err = encoder.Encode(&buf, rgba); err != nil {
+		return fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	if err = fits(buf.Bytes()); err != nil {
+		return fmt.Errorf("%w: %s", ErrRGBATooLarge, err)
+	}
+
+	if err = os.WriteFile(path, buf.Bytes(), fileModeReadWrite); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
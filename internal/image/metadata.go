@@ -0,0 +1,84 @@
+package image
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image/png"
+	"io"
+	"os"
+)
+
+var ErrNotSmaller = errors.New("re-encoded image is not smaller")
+
+// metadataChunks are ancillary PNG chunks which carry text or EXIF metadata and don't affect the image.
+var metadataChunks = map[string]struct{}{"iTXt": {}, "tEXt": {}, "zTXt": {}, "eXIf": {}}
+
+// HasMetadata checks whether PNG image has text or EXIF metadata chunks, without decoding the image data.
+func HasMetadata(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+
+	signature := make([]byte, len(pngSignature))
+	if _, err = io.ReadFull(r, signature); err != nil || !bytes.Equal(signature, pngSignature) {
+		return false, fmt.Errorf("%w: not a png image", ErrUnsupportedFormat)
+	}
+
+	header := make([]byte, pngChunkHeaderSize)
+	for {
+		if _, err = io.ReadFull(r, header); err != nil {
+			return false, fmt.Errorf("failed to read png chunk: %w", err)
+		}
+
+		chunkType := string(header[4:])
+		if _, ok := metadataChunks[chunkType]; ok {
+			return true, nil
+		}
+
+		// Metadata chunks may follow image data, so the whole file is read.
+		if chunkType == "IEND" {
+			return false, nil
+		}
+
+		if _, err = r.Discard(int(binary.BigEndian.Uint32(header[:4])) + pngChunkCRCSize); err != nil {
+			return false, fmt.Errorf("failed to read png chunk: %w", err)
+		}
+	}
+}
+
+// StripMetadata re-encodes PNG image, the encoder writes no ancillary chunks. The file is left untouched
+// with ErrNotSmaller when the re-encoded image is not smaller than the original.
+func StripMetadata(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to decode png image: %w", err)
+	}
+
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err = encoder.Encode(&buf, img); err != nil {
+		return fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	if buf.Len() >= len(data) {
+		return fmt.Errorf("%w: %d bytes, original %d bytes", ErrNotSmaller, buf.Len(), len(data))
+	}
+
+	if err = os.WriteFile(path, buf.Bytes(), fileModeReadWrite); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
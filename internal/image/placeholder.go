@@ -0,0 +1,63 @@
+package image
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image/png"
+	"math"
+	"os"
+)
+
+var ErrPlaceholderLogo = errors.New("logo is a placeholder image")
+
+// LuminanceVariance decodes PNG image and returns variance of luminance of its pixels in 0-255 range.
+// Transparent pixels are composited over black and over white backgrounds, the larger variance is returned,
+// so both dark and light shapes on a transparent background are not uniform.
+func LuminanceVariance(path string) (float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode png image: %w", err)
+	}
+
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return 0, nil
+	}
+
+	var overBlack, overWhite variance
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// Components are alpha-premultiplied, i.e. already composited over black.
+			r, g, b, a := img.At(x, y).RGBA()
+
+			// Rec. 601 luma of 16-bit components, scaled to 0-255.
+			luminance := (0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)) / 257
+			overBlack.add(luminance)
+			overWhite.add(luminance + float64(0xffff-a)/257)
+		}
+	}
+
+	return math.Max(overBlack.value(), overWhite.value()), nil
+}
+
+type variance struct {
+	count, sum, sumSquares float64
+}
+
+func (v *variance) add(value float64) {
+	v.count++
+	v.sum += value
+	v.sumSquares += value * value
+}
+
+func (v *variance) value() float64 {
+	mean := v.sum / v.count
+
+	return v.sumSquares/v.count - mean*mean
+}
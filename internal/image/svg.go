@@ -0,0 +1,62 @@
+package image
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/srwiley/oksvg"
+	"github.com/srwiley/rasterx"
+)
+
+// svgRasterSize is the width and height of PNG images rendered from SVG images.
+const svgRasterSize = 256
+
+var (
+	svgTag    = []byte("<svg")
+	xmlHeader = []byte("<?xml")
+	utf8BOM   = []byte("\xEF\xBB\xBF")
+)
+
+// isSVG checks that the content starts with svg tag, or with XML header of a document with svg tag.
+func isSVG(data []byte) bool {
+	data = bytes.TrimLeft(bytes.TrimPrefix(data, utf8BOM), " \t\r\n")
+
+	return bytes.HasPrefix(data, svgTag) || bytes.HasPrefix(data, xmlHeader) && bytes.Contains(data, svgTag)
+}
+
+// decodeSVGConfig returns dimensions of the SVG view box.
+func decodeSVGConfig(data []byte) (image.Config, error) {
+	icon, err := oksvg.ReadIconStream(bytes.NewReader(data), oksvg.IgnoreErrorMode)
+	if err != nil {
+		return image.Config{}, err
+	}
+
+	return image.Config{
+		Width:  int(math.Round(icon.ViewBox.W)),
+		Height: int(math.Round(icon.ViewBox.H)),
+	}, nil
+}
+
+// rasterizeSVG renders SVG image to a transparent square of svgRasterSize, keeping its aspect ratio.
+func rasterizeSVG(data []byte) (image.Image, error) {
+	icon, err := oksvg.ReadIconStream(bytes.NewReader(data), oksvg.IgnoreErrorMode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode svg image: %w", err)
+	}
+
+	targetW, targetH := float64(svgRasterSize), float64(svgRasterSize)
+	if w, h := icon.ViewBox.W, icon.ViewBox.H; w > 0 && h > 0 {
+		scale := math.Min(targetW/w, targetH/h)
+		targetW, targetH = w*scale, h*scale
+	}
+
+	icon.SetTarget((svgRasterSize-targetW)/2, (svgRasterSize-targetH)/2, targetW, targetH)
+
+	img := image.NewRGBA(image.Rect(0, 0, svgRasterSize, svgRasterSize))
+	scanner := rasterx.NewScannerGV(svgRasterSize, svgRasterSize, img, img.Bounds())
+	icon.Draw(rasterx.NewDasher(svgRasterSize, svgRasterSize, scanner), 1)
+
+	return img, nil
+}
@@ -0,0 +1,71 @@
+package image
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"math"
+	"os"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+// Thumbnail decodes PNG or WebP image and scales it to a square of the given size with bilinear interpolation.
+func Thumbnail(path string, size int) (*image.RGBA, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var img image.Image
+	switch format := DetectFormat(data); format {
+	case FormatPNG:
+		img, err = png.Decode(bytes.NewReader(data))
+	case FormatWebP:
+		img, err = webp.Decode(bytes.NewReader(data))
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	thumbnail := image.NewRGBA(image.Rect(0, 0, size, size))
+	xdraw.BiLinear.Scale(thumbnail, thumbnail.Bounds(), img, img.Bounds(), draw.Src, nil)
+
+	return thumbnail, nil
+}
+
+// ThumbnailSheet draws thumbnails of the given size into a square grid, in the order of paths. Images which fail
+// to decode are passed to skip and left out of the grid. Returns nil if no image is drawn.
+func ThumbnailSheet(paths []string, size int, skip func(path string, err error)) *image.RGBA {
+	thumbnails := make([]*image.RGBA, 0, len(paths))
+	for _, path := range paths {
+		thumbnail, err := Thumbnail(path, size)
+		if err != nil {
+			skip(path, err)
+			continue
+		}
+
+		thumbnails = append(thumbnails, thumbnail)
+	}
+
+	if len(thumbnails) == 0 {
+		return nil
+	}
+
+	columns := int(math.Ceil(math.Sqrt(float64(len(thumbnails)))))
+	rows := (len(thumbnails) + columns - 1) / columns
+	sheet := image.NewRGBA(image.Rect(0, 0, columns*size, rows*size))
+
+	for i, thumbnail := range thumbnails {
+		x, y := i%columns*size, i/columns*size
+		draw.Draw(sheet, image.Rect(x, y, x+size, y+size), thumbnail, image.Point{}, draw.Src)
+	}
+
+	return sheet
+}
@@ -0,0 +1,164 @@
+package processor
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+	"github.com/trustwallet/assets/internal/config"
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+// AddressConflict is an asset address used by more than one chain.
+type AddressConflict struct {
+	Address string
+	Chains  []string
+}
+
+// ValidateNoDuplicateAddresses finds asset addresses which are used on several chains. Chains sharing
+// the address space, listed in config, are not compared with each other and with other chains.
+func (s *Service) ValidateNoDuplicateAddresses(ctx context.Context) ([]AddressConflict, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	chains, err := getChainHandles()
+	if err != nil {
+		return nil, err
+	}
+
+	sharedAddressChains := make(map[string]struct{})
+	for _, chain := range config.Default.ValidatorsSettings.AssetAddresses.SharedAddressChains {
+		sharedAddressChains[chain] = struct{}{}
+	}
+
+	index := make(map[string][]string)
+
+	for _, chain := range chains {
+		if _, ok := sharedAddressChains[chain]; ok || !fileLib.FileExists(getChainAssetsPath(chain)) {
+			continue
+		}
+
+		assetIDs, err := getChainAssetIDs(chain)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assetID := range assetIDs {
+			address := normalizeAddress(chain, assetID)
+			index[address] = append(index[address], chain)
+		}
+	}
+
+	var conflicts []AddressConflict
+	for address, addressChains := range index {
+		if len(addressChains) > 1 {
+			conflicts = append(conflicts, AddressConflict{Address: address, Chains: addressChains})
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Address < conflicts[j].Address
+	})
+
+	return conflicts, nil
+}
+
+// ConsistencyConflict is an asset address with different names or symbols on different chains.
+type ConsistencyConflict struct {
+	Address string
+	Assets  []CrossChainAsset
+}
+
+// CrossChainAsset is name and symbol of an asset info file of the chain.
+type CrossChainAsset struct {
+	Chain  string
+	Name   string
+	Symbol string
+}
+
+// ValidateCrossChainConsistency finds asset addresses present on several chains, e.g. bridged tokens, with names or
+// symbols of their info files differing case-insensitively.
+func (s *Service) ValidateCrossChainConsistency(ctx context.Context) ([]ConsistencyConflict, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	chains, err := getChainHandles()
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string][]CrossChainAsset)
+
+	for _, chain := range chains {
+		if !fileLib.FileExists(getChainAssetsPath(chain)) {
+			continue
+		}
+
+		assetIDs, err := getChainAssetIDs(chain)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assetID := range assetIDs {
+			if err = ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			infoPath := path.GetAssetInfoPath(chain, assetID)
+			if !fileLib.FileExists(infoPath) {
+				continue
+			}
+
+			var assetInfo info.AssetModel
+			if err = fileLib.ReadJSONFile(infoPath, &assetInfo); err != nil {
+				return nil, err
+			}
+
+			address := normalizeAddress(chain, assetID)
+			index[address] = append(index[address], CrossChainAsset{
+				Chain:  chain,
+				Name:   stringValue(assetInfo.Name),
+				Symbol: stringValue(assetInfo.Symbol),
+			})
+		}
+	}
+
+	var conflicts []ConsistencyConflict
+	for address, assets := range index {
+		if len(assets) > 1 && !equalCrossChainAssets(assets) {
+			conflicts = append(conflicts, ConsistencyConflict{Address: address, Assets: assets})
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Address < conflicts[j].Address
+	})
+
+	return conflicts, nil
+}
+
+func equalCrossChainAssets(assets []CrossChainAsset) bool {
+	first := assets[0]
+	for _, asset := range assets[1:] {
+		if !strings.EqualFold(strings.TrimSpace(asset.Name), strings.TrimSpace(first.Name)) ||
+			!strings.EqualFold(strings.TrimSpace(asset.Symbol), strings.TrimSpace(first.Symbol)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeAddress lower-cases EVM addresses, which differ only by the checksum case.
+func normalizeAddress(chainHandle, address string) string {
+	if c, err := coin.GetCoinForId(chainHandle); err == nil && coin.IsEVM(c.ID) {
+		return strings.ToLower(address)
+	}
+
+	return address
+}
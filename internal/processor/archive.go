@@ -0,0 +1,93 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// archivedStatuses are statuses of assets moved out of the chain by ArchiveInactiveAssets.
+var archivedStatuses = map[string]struct{}{
+	"inactive": {},
+	"spam":     {},
+}
+
+// ArchiveInactiveAssets moves folders of inactive and spam assets of the chain to <archiveRoot>/<chain>/<asset>.
+// In dry-run mode folders are left in place and only logged. Returns the number of archived assets.
+func (s *Service) ArchiveInactiveAssets(
+	ctx context.Context, chainHandle, archiveRoot string, dryRun bool,
+) (archived int, err error) {
+	if err = ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return 0, err
+	}
+
+	compErr := validation.NewErrComposite()
+
+	for _, assetID := range assetIDs {
+		if err = ctx.Err(); err != nil {
+			return archived, err
+		}
+
+		var assetInfo info.AssetModel
+		if err = fileLib.ReadJSONFile(path.GetAssetInfoPath(chainHandle, assetID), &assetInfo); err != nil {
+			continue
+		}
+
+		if _, ok := archivedStatuses[assetInfo.GetStatus()]; !ok {
+			continue
+		}
+
+		assetPath := path.GetAssetPath(chainHandle, assetID)
+		archivePath := filepath.Join(archiveRoot, chainHandle, assetID)
+
+		logger := log.WithField("from", assetPath).WithField("to", archivePath)
+		if dryRun {
+			logger.Info("[dry-run] Asset is not archived")
+			continue
+		}
+
+		if err = archiveAssetFolder(assetPath, archivePath); err != nil {
+			compErr.Append(err)
+			continue
+		}
+
+		logger.Debug("Archived asset")
+
+		archived++
+	}
+
+	if compErr.Len() > 0 {
+		return archived, compErr
+	}
+
+	return archived, nil
+}
+
+func archiveAssetFolder(assetPath, archivePath string) error {
+	if fileLib.FileExists(archivePath) {
+		return fmt.Errorf("archived asset %s already exists", archivePath)
+	}
+
+	if err := fileLib.CreateDirPath(archivePath); err != nil {
+		return err
+	}
+
+	if err := os.Rename(assetPath, archivePath); err != nil {
+		return fmt.Errorf("failed to move asset folder: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation"
+)
+
+// BatchResult lists asset info files modified by a batch fix and errors of assets that failed, keyed by asset path.
+type BatchResult struct {
+	Modified []string
+	Failed   map[string]error
+}
+
+// FixAssetInfoBatch applies FixAssetInfoJSON to info files of all assets of the chain. Each info file is copied to
+// a temp dir before it is fixed. When any asset fails, or the context is canceled, all files written by the batch
+// are restored from the copies after the walk, Modified is cleared and ErrBatchRolledBack is returned.
+func (s *Service) FixAssetInfoBatch(ctx context.Context, chainHandle string) (*BatchResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	backupDir, err := os.MkdirTemp("", fmt.Sprintf("%s-batch-*", chainHandle))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backup dir: %w", err)
+	}
+	defer os.RemoveAll(backupDir)
+
+	result := &BatchResult{Failed: make(map[string]error)}
+
+	// backups maps written info files to their copies.
+	backups := make(map[string]string)
+
+	for i, assetID := range assetIDs {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+
+		infoPath := path.GetAssetInfoPath(chainHandle, assetID)
+		if _, e := os.Stat(infoPath); os.IsNotExist(e) {
+			continue
+		}
+
+		backupPath := filepath.Join(backupDir, strconv.Itoa(i))
+		if e := copyFile(infoPath, backupPath); e != nil {
+			result.Failed[path.GetAssetPath(chainHandle, assetID)] = e
+			continue
+		}
+
+		f := s.fileService.GetAssetFile(fmt.Sprintf("./%s", infoPath))
+
+		fixResult, e := s.FixAssetInfoJSON(ctx, f)
+		if e != nil {
+			// The fixer may fail after the file is written.
+			backups[infoPath] = backupPath
+			result.Failed[path.GetAssetPath(chainHandle, assetID)] = e
+
+			continue
+		}
+
+		if fixResult != nil {
+			backups[infoPath] = backupPath
+			result.Modified = append(result.Modified, infoPath)
+		}
+	}
+
+	if len(result.Failed) == 0 && err == nil {
+		return result, nil
+	}
+
+	compErr := validation.NewErrComposite()
+	for infoPath, backupPath := range backups {
+		if e := restoreFile(backupPath, infoPath); e != nil {
+			compErr.Append(e)
+		}
+	}
+
+	if compErr.Len() > 0 {
+		return result, fmt.Errorf("%w: failed to restore files: %s", ErrBatchRolledBack, compErr)
+	}
+
+	result.Modified = nil
+
+	if err != nil {
+		return result, fmt.Errorf("%w: %s", ErrBatchRolledBack, err)
+	}
+
+	return result, fmt.Errorf("%w: %d assets failed", ErrBatchRolledBack, len(result.Failed))
+}
+
+func copyFile(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(dst, data, fileModeReadWrite)
+}
+
+func restoreFile(backupPath, filePath string) error {
+	data, err := os.ReadFile(backupPath)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(filePath, data)
+}
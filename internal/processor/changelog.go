@@ -0,0 +1,63 @@
+package processor
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+// GenerateChangelogEntry formats the fix result as a markdown list item, prefixed with the chain symbol, e.g.
+// "- [ETH] Renamed `0xdeadbeef` to `0xDeadBeef` (EIP-55 checksum)".
+func (s *Service) GenerateChangelogEntry(result *Result) string {
+	var entry strings.Builder
+
+	entry.WriteString("- ")
+
+	chain, ok := resultChain(result.Path)
+	if ok {
+		fmt.Fprintf(&entry, "[%s] ", chain.Symbol)
+	}
+
+	action := result.Action
+	if action != "" {
+		action = strings.ToUpper(action[:1]) + action[1:]
+	}
+
+	switch {
+	case result.Action == ActionRenamed:
+		fmt.Fprintf(&entry, "%s `%s` to `%s`", action, result.Before, result.After)
+
+		if ok && coin.IsEVM(chain.ID) {
+			entry.WriteString(" (EIP-55 checksum)")
+		} else if ok && chain.ID == coin.SOLANA {
+			entry.WriteString(" (canonical base58 address)")
+		}
+	case result.Before != "" || result.After != "":
+		fmt.Fprintf(&entry, "%s `%s` from `%s` to `%s`", action, result.Path, result.Before, result.After)
+	default:
+		fmt.Fprintf(&entry, "%s `%s`", action, result.Path)
+	}
+
+	return entry.String()
+}
+
+// resultChain returns the chain of a path inside the chains folder.
+func resultChain(resultPath string) (coin.Coin, bool) {
+	parts := strings.Split(filepath.ToSlash(filepath.Clean(resultPath)), "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] != chainsPath {
+			continue
+		}
+
+		chain, err := coin.GetCoinForId(parts[i+1])
+		if err != nil {
+			return coin.Coin{}, false
+		}
+
+		return chain, true
+	}
+
+	return coin.Coin{}, false
+}
@@ -0,0 +1,271 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+	"github.com/trustwallet/assets/internal/config"
+	"github.com/trustwallet/assets/internal/file"
+	"github.com/trustwallet/go-primitives/address"
+	"github.com/trustwallet/go-primitives/coin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	linkNameCoinGecko = "coingecko"
+
+	coinGeckoAPIKeyHeader = "x-cg-demo-api-key"
+	coinGeckoCoinURL      = "https://coingecko.com/en/coins/%s"
+)
+
+type coinGeckoCoin struct {
+	ID        string            `json:"id"`
+	Symbol    string            `json:"symbol"`
+	Name      string            `json:"name"`
+	Platforms map[string]string `json:"platforms,omitempty"`
+}
+
+// coinGeckoCoins caches the CoinGecko coins list. Only a fetched list is cached, failed fetches are retried
+// by the next caller with its own context.
+type coinGeckoCoins struct {
+	mu      sync.Mutex
+	coins   []coinGeckoCoin
+	fetched bool
+}
+
+// fixCoinGeckoLink adds a coingecko link when the CoinGecko API key is set and exactly one listed coin
+// has the asset symbol and name.
+func (s *Service) fixCoinGeckoLink(ctx context.Context, f *file.AssetFile, assetInfo *info.AssetModel) bool {
+	if s.coinGeckoAPIKey == "" || assetInfo.Symbol == nil || assetInfo.Name == nil {
+		return false
+	}
+
+	if hasLink(assetInfo, linkNameCoinGecko) {
+		return false
+	}
+
+	coins, err := s.getCoinGeckoCoins(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Failed to fetch CoinGecko coins list")
+
+		return false
+	}
+
+	var matches []string
+	for _, c := range coins {
+		if strings.EqualFold(c.Symbol, *assetInfo.Symbol) && strings.EqualFold(c.Name, *assetInfo.Name) {
+			matches = append(matches, c.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return false
+	case 1:
+		name, url := linkNameCoinGecko, fmt.Sprintf(coinGeckoCoinURL, matches[0])
+		assetInfo.Links = append(assetInfo.Links, info.Link{Name: &name, URL: &url})
+
+		return true
+	default:
+		log.WithField("path", f.Path()).
+			WithField("ids", matches).
+			Warn("Several CoinGecko coins match the asset, coingecko link is not added")
+
+		return false
+	}
+}
+
+func (s *Service) getCoinGeckoCoins(ctx context.Context) ([]coinGeckoCoin, error) {
+	s.coinGeckoCoins.mu.Lock()
+	defer s.coinGeckoCoins.mu.Unlock()
+
+	if s.coinGeckoCoins.fetched {
+		return s.coinGeckoCoins.coins, nil
+	}
+
+	coins, err := s.fetchCoinGeckoCoins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.coinGeckoCoins.coins, s.coinGeckoCoins.fetched = coins, true
+
+	return coins, nil
+}
+
+// GenerateTokenListFromCoingecko bootstraps a new chain from coins listed by CoinGecko on the platform: creates
+// asset folders with info.json and an empty logo.png, and writes the token list. Existing assets are not
+// overwritten, they are added to the token list as is. Decimals are fetched from the chain RPC endpoint,
+// coins without them are skipped and reported in the returned error. Chains which already have a token list
+// are refused, the list would be replaced by CoinGecko coins only.
+func (s *Service) GenerateTokenListFromCoingecko(ctx context.Context, chainHandle, platformID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	chain, err := coin.GetCoinForId(chainHandle)
+	if err != nil {
+		return err
+	}
+
+	if tokenListPath := path.GetTokenListPath(chain.Handle); fileLib.FileExists(tokenListPath) {
+		return fmt.Errorf("token list %s already exists", tokenListPath)
+	}
+
+	coins, err := s.fetchCoinGeckoPlatformCoins(ctx)
+	if err != nil {
+		return err
+	}
+
+	compErr := validation.NewErrComposite()
+	var tokens []TokenItem
+
+	for _, c := range coins {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		contract := strings.TrimSpace(c.Platforms[platformID])
+		if contract == "" {
+			continue
+		}
+
+		assetInfo, err := s.bootstrapCoinGeckoAsset(ctx, chain, contract, c)
+		if err != nil {
+			compErr.Append(fmt.Errorf("%s: %w", c.ID, err))
+			continue
+		}
+
+		tokens = append(tokens, newTokenItem(chain, *assetInfo.ID, assetInfo))
+	}
+
+	if err = s.writeChainTokenList(chain, tokens); err != nil {
+		return err
+	}
+
+	if compErr.Len() > 0 {
+		return compErr
+	}
+
+	return nil
+}
+
+// bootstrapCoinGeckoAsset returns info of the existing asset, or creates the asset stub.
+func (s *Service) bootstrapCoinGeckoAsset(
+	ctx context.Context, chain coin.Coin, contract string, c coinGeckoCoin,
+) (*info.AssetModel, error) {
+	if coin.IsEVM(chain.ID) {
+		checksum, err := address.EIP55Checksum(contract)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", validation.ErrInvalidAddress, err)
+		}
+
+		contract = checksum
+	}
+
+	if err := validation.ValidateAssetAddress(chain, contract); err != nil {
+		return nil, err
+	}
+
+	assetInfoPath := path.GetAssetInfoPath(chain.Handle, contract)
+	if fileLib.FileExists(assetInfoPath) {
+		var assetInfo info.AssetModel
+		if err := fileLib.ReadJSONFile(assetInfoPath, &assetInfo); err != nil {
+			return nil, err
+		}
+
+		assetInfo.ID = &contract
+
+		return &assetInfo, nil
+	}
+
+	if !s.hasRPCEndpoint(chain) {
+		return nil, fmt.Errorf("%w: %s", errNoRPCEndpoint, chain.Handle)
+	}
+
+	decimals, err := s.fetchTokenDecimals(ctx, chain, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	assetInfo, err := s.newAssetInfo(chain, contract, c.Name, strings.ToUpper(c.Symbol), decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = fileLib.CreateDirPath(assetInfoPath); err != nil {
+		return nil, err
+	}
+
+	if err = s.createJSONFile(assetInfoPath, assetInfo); err != nil {
+		return nil, err
+	}
+
+	// Logo is left empty to be added manually, logo validation fails until then.
+	logoPath := path.GetAssetLogoPath(chain.Handle, contract)
+	if err = os.WriteFile(logoPath, nil, fileModeReadWrite); err != nil {
+		return nil, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return assetInfo, nil
+}
+
+func (s *Service) fetchCoinGeckoCoins(ctx context.Context) ([]coinGeckoCoin, error) {
+	return s.fetchCoinGeckoList(ctx, fmt.Sprintf("%s/coins/list", config.Default.ClientURLs.CoinGecko))
+}
+
+// fetchCoinGeckoPlatformCoins fetches coins list with contract addresses keyed by platform ID.
+func (s *Service) fetchCoinGeckoPlatformCoins(ctx context.Context) ([]coinGeckoCoin, error) {
+	return s.fetchCoinGeckoList(ctx,
+		fmt.Sprintf("%s/coins/list?include_platform=true", config.Default.ClientURLs.CoinGecko))
+}
+
+func (s *Service) fetchCoinGeckoList(ctx context.Context, url string) ([]coinGeckoCoin, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(coinGeckoAPIKeyHeader, s.coinGeckoAPIKey)
+
+	if err = s.CheckRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make GET request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var coins []coinGeckoCoin
+	if err = json.NewDecoder(resp.Body).Decode(&coins); err != nil {
+		return nil, fmt.Errorf("failed to decode coins list: %w", err)
+	}
+
+	return coins, nil
+}
+
+func hasLink(assetInfo *info.AssetModel, name string) bool {
+	for _, link := range assetInfo.Links {
+		if link.Name != nil && *link.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,142 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	DiffAdded    = "added"
+	DiffRemoved  = "removed"
+	DiffModified = "modified"
+)
+
+type DiffEntry struct {
+	Action string `json:"action"`
+	Path   string `json:"path"`
+	Chain  string `json:"chain"`
+	Asset  string `json:"asset,omitempty"`
+}
+
+// ExportDiff compares chain files of two trees by SHA-256 of their content and writes
+// added, removed and modified files as a JSON array sorted by path.
+func (s *Service) ExportDiff(ctx context.Context, oldRoot, newRoot string, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var (
+		wg               sync.WaitGroup
+		oldSums, newSums map[string]string
+		oldErr, newErr   error
+	)
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		oldSums, oldErr = treeSHA256(ctx, oldRoot)
+	}()
+
+	go func() {
+		defer wg.Done()
+		newSums, newErr = treeSHA256(ctx, newRoot)
+	}()
+
+	wg.Wait()
+
+	if oldErr != nil {
+		return oldErr
+	}
+
+	if newErr != nil {
+		return newErr
+	}
+
+	entries := make([]DiffEntry, 0)
+
+	for p, sum := range newSums {
+		oldSum, ok := oldSums[p]
+		switch {
+		case !ok:
+			entries = append(entries, newDiffEntry(DiffAdded, p))
+		case oldSum != sum:
+			entries = append(entries, newDiffEntry(DiffModified, p))
+		}
+	}
+
+	for p := range oldSums {
+		if _, ok := newSums[p]; !ok {
+			entries = append(entries, newDiffEntry(DiffRemoved, p))
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", string(s.indentStyle))
+
+	return encoder.Encode(entries)
+}
+
+// treeSHA256 returns SHA-256 of files in the chains folder of the root, keyed by path relative to the root.
+func treeSHA256(ctx context.Context, root string) (map[string]string, error) {
+	sums := make(map[string]string)
+
+	err := filepath.Walk(filepath.Join(root, chainsPath), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		sum, err := fileSHA256(path)
+		if err != nil {
+			return err
+		}
+
+		sums[filepath.ToSlash(rel)] = sum
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sums, nil
+}
+
+// newDiffEntry fills chain and asset from a "blockchains/<chain>/assets/<asset>/..." path.
+func newDiffEntry(action, path string) DiffEntry {
+	entry := DiffEntry{Action: action, Path: path}
+
+	parts := strings.Split(path, "/")
+	if len(parts) > 2 {
+		entry.Chain = parts[1]
+	}
+
+	if len(parts) > 4 && parts[2] == "assets" {
+		entry.Asset = parts[3]
+	}
+
+	return entry
+}
@@ -0,0 +1,58 @@
+package processor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/trustwallet/assets-go-libs/validation"
+)
+
+var (
+	ErrUTF8BOM     = errors.New("file starts with UTF-8 byte order mark")
+	ErrInvalidUTF8 = errors.New("file is not valid UTF-8")
+
+	ErrBatchRolledBack   = errors.New("batch is rolled back")
+	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+	ErrSignificantDrop   = errors.New("token count dropped significantly")
+	ErrSymlink           = errors.New("symbolic links are not allowed")
+
+	ErrNameTooShort     = errors.New("asset name is too short")
+	ErrNameTooLong      = errors.New("asset name is too long")
+	ErrNameNotPrintable = errors.New("asset name contains non-printable characters")
+	ErrNameUntrimmed    = errors.New("asset name starts or ends with whitespace")
+
+	ErrSymbolLength     = errors.New("asset symbol has invalid length")
+	ErrSymbolCharacters = errors.New("asset symbol contains characters other than letters, digits and hyphens")
+	ErrSymbolNoLetter   = errors.New("asset symbol has no letters")
+)
+
+// ValidationError points to a token list item that misses a required field.
+type ValidationError struct {
+	Index int
+	Field string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: token #%d has no '%s'", validation.ErrMissingField, e.Index, e.Field)
+}
+
+func (e ValidationError) Unwrap() error {
+	return validation.ErrMissingField
+}
+
+// SchemaError points to a token list value that violates a constraint of the token list JSON schema.
+// Path is a JSON pointer to the value, Constraint is a JSON pointer to the schema keyword.
+type SchemaError struct {
+	Path       string
+	Constraint string
+	Message    string
+}
+
+func (e SchemaError) Error() string {
+	return fmt.Sprintf("%s: '%s' does not validate with '%s': %s",
+		validation.ErrInvalidJson, e.Path, e.Constraint, e.Message)
+}
+
+func (e SchemaError) Unwrap() error {
+	return validation.ErrInvalidJson
+}
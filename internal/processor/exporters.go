@@ -0,0 +1,168 @@
+package processor
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode/utf8"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+)
+
+// csvFlushRows is a number of rows written between flushes of CSV writer.
+const csvFlushRows = 100
+
+var tokenListCSVHeader = []string{"address", "name", "symbol", "decimals", "logoURI", "status"}
+
+var assetInfoCSVHeader = []string{
+	"chain", "address", "name", "symbol", "decimals", "status", "website", "explorer", "description_length", "has_logo",
+}
+
+// ExportTokenListCSV writes tokens of the chain token list as CSV rows.
+// Status is taken from the asset info file, and is empty if the asset has none.
+func (s *Service) ExportTokenListCSV(ctx context.Context, chainHandle string, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var tokenList TokenList
+	if err := fileLib.ReadJSONFile(path.GetTokenListPath(chainHandle), &tokenList); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(tokenListCSVHeader); err != nil {
+		return fmt.Errorf("failed to write csv header: %w", err)
+	}
+
+	for i, token := range tokenList.Tokens {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		row := []string{
+			token.Address,
+			token.Name,
+			token.Symbol,
+			strconv.FormatUint(uint64(token.Decimals), 10),
+			token.LogoURI,
+			assetStatus(chainHandle, token.Address),
+		}
+
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write csv row: %w", err)
+		}
+
+		if (i+1)%csvFlushRows == 0 {
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return fmt.Errorf("failed to flush csv: %w", err)
+			}
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func assetStatus(chainHandle, assetID string) string {
+	assetInfoPath := path.GetAssetInfoPath(chainHandle, assetID)
+	if assetID == "" || !fileLib.FileExists(assetInfoPath) {
+		return ""
+	}
+
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(assetInfoPath, &assetInfo); err != nil {
+		return ""
+	}
+
+	return assetInfo.GetStatus()
+}
+
+// ExportAssetInfoCSV writes metadata of assets of the chain, or of all chains when chainHandle is empty, as CSV rows.
+// Description length is counted in characters, has_logo tells whether the asset folder has logo.png.
+func (s *Service) ExportAssetInfoCSV(ctx context.Context, chainHandle string, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	chains := []string{chainHandle}
+	if chainHandle == "" {
+		var err error
+		if chains, err = getChainHandles(); err != nil {
+			return err
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(assetInfoCSVHeader); err != nil {
+		return fmt.Errorf("failed to write csv header: %w", err)
+	}
+
+	var rows int
+	for _, chain := range chains {
+		if !fileLib.FileExists(getChainAssetsPath(chain)) {
+			continue
+		}
+
+		assetIDs, err := getChainAssetIDs(chain)
+		if err != nil {
+			return err
+		}
+
+		for _, assetID := range assetIDs {
+			if err = ctx.Err(); err != nil {
+				return err
+			}
+
+			assetInfoPath := path.GetAssetInfoPath(chain, assetID)
+			if !fileLib.FileExists(assetInfoPath) {
+				continue
+			}
+
+			var assetInfo info.AssetModel
+			if err = fileLib.ReadJSONFile(assetInfoPath, &assetInfo); err != nil {
+				return err
+			}
+
+			var decimals string
+			if assetInfo.Decimals != nil {
+				decimals = strconv.Itoa(*assetInfo.Decimals)
+			}
+
+			row := []string{
+				chain,
+				assetID,
+				stringValue(assetInfo.Name),
+				stringValue(assetInfo.Symbol),
+				decimals,
+				assetInfo.GetStatus(),
+				stringValue(assetInfo.Website),
+				stringValue(assetInfo.Explorer),
+				strconv.Itoa(utf8.RuneCountInString(stringValue(assetInfo.Description))),
+				strconv.FormatBool(fileLib.FileExists(path.GetAssetLogoPath(chain, assetID))),
+			}
+
+			if err = writer.Write(row); err != nil {
+				return fmt.Errorf("failed to write csv row: %w", err)
+			}
+
+			rows++
+			if rows%csvFlushRows == 0 {
+				writer.Flush()
+				if err = writer.Error(); err != nil {
+					return fmt.Errorf("failed to flush csv: %w", err)
+				}
+			}
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -1,17 +1,30 @@
 package processor
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
+	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
+	"github.com/mr-tron/base58"
 	fileLib "github.com/trustwallet/assets-go-libs/file"
-	"github.com/trustwallet/assets-go-libs/image"
+	imageLib "github.com/trustwallet/assets-go-libs/image"
 	"github.com/trustwallet/assets-go-libs/path"
 	"github.com/trustwallet/assets-go-libs/validation"
 	"github.com/trustwallet/assets-go-libs/validation/info"
 	"github.com/trustwallet/assets/internal/file"
+	"github.com/trustwallet/assets/internal/image"
 	"github.com/trustwallet/go-primitives/address"
 	"github.com/trustwallet/go-primitives/coin"
 	"github.com/trustwallet/go-primitives/types"
@@ -19,13 +32,141 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func (s *Service) FixJSON(f *file.AssetFile) error {
-	return fileLib.FormatJSONFile(f.Path())
+const (
+	// jsonIndent matches indentation of JSON files written by assets-go-libs.
+	jsonIndent = "    "
+
+	// IndentFourSpaces is the default indentation of JSON files written by the service.
+	IndentFourSpaces IndentStyle = jsonIndent
+	IndentTwoSpaces  IndentStyle = "  "
+	IndentTab        IndentStyle = "\t"
+
+	solanaPublicKeyLength = 32
+
+	fileModeReadWrite = 0600
+
+	explorerAddressPlaceholder = "{address}"
+	logoChainPlaceholder       = "{chain}"
+	logoAssetPlaceholder       = "{asset}"
+
+	linkNameWebsite       = "website"
+	linkNameTwitter       = "twitter"
+	linkNameCoinMarketCap = "coinmarketcap"
+)
+
+// legacyLinkFields maps lower-cased top-level fields of legacy asset info files to names of links they are moved to.
+var legacyLinkFields = map[string]string{
+	"coingecko":   linkNameCoinGecko,
+	"discord":     "discord",
+	"facebook":    "facebook",
+	"light_paper": "whitepaper",
+	"medium":      "medium",
+	"telegram":    "telegram",
+}
+
+// IndentStyle is a string used for one level of indentation of JSON files.
+type IndentStyle string
+
+func (s *Service) FixJSON(ctx context.Context, f *file.AssetFile) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(f.Path())
+	if err != nil {
+		return nil, newFixError(f, ActionReformatted, err)
+	}
+
+	// Byte order mark is left by Windows editors, JSON decoders reject it.
+	content := bytes.TrimPrefix(data, utf8BOM)
+
+	var formatted bytes.Buffer
+	if err = json.Indent(&formatted, content, "", string(s.indentStyle)); err != nil {
+		var syntaxErr *json.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			return nil, newFixError(f, ActionReformatted, err)
+		}
+
+		// Comments and trailing commas are often left from editor templates.
+		formatted.Reset()
+		if err = json.Indent(&formatted, stripJSONComments(content), "", string(s.indentStyle)); err != nil {
+			return nil, newFixError(f, ActionReformatted, err)
+		}
+
+		log.WithField("path", f.Path()).Warn("Stripped comments or trailing commas from json file")
+	}
+
+	if bytes.Equal(formatted.Bytes(), data) {
+		return nil, nil
+	}
+
+	return s.applyFix(f, &Result{Path: f.Path(), Action: ActionReformatted}, func() error {
+		return writeFileAtomic(f.Path(), formatted.Bytes())
+	})
+}
+
+// writeFileAtomic writes data to a temp file in the same dir and renames it over the file, so the file is never
+// left partially written. Permissions of the file are kept.
+func writeFileAtomic(filePath string, data []byte) error {
+	stat, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), fmt.Sprintf(".%s-*", filepath.Base(filePath)))
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err = tmp.Chmod(stat.Mode().Perm()); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set temp file mode: %w", err)
+	}
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("failed to replace file: %w", err)
+	}
+
+	return nil
 }
 
-func (s *Service) FixETHAddressChecksum(f *file.AssetFile) error {
+// createJSONFile writes payload to the file like fileLib.CreateJSONFile, indented by the style set by
+// WithIndentStyle, so generated files match files reformatted by FixJSON.
+func (s *Service) createJSONFile(filePath string, payload interface{}) error {
+	data, err := json.MarshalIndent(payload, "", string(s.indentStyle))
+	if err != nil {
+		return fmt.Errorf("failed to marshal json: %w", err)
+	}
+
+	// Special HTML characters are escaped by json.Marshal.
+	data = bytes.ReplaceAll(data, []byte("\\u003c"), []byte("<"))
+	data = bytes.ReplaceAll(data, []byte("\\u003e"), []byte(">"))
+	data = bytes.ReplaceAll(data, []byte("\\u0026"), []byte("&"))
+
+	if err = os.WriteFile(filePath, data, fileModeReadWrite); err != nil {
+		return fmt.Errorf("failed to write json to file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) FixETHAddressChecksum(ctx context.Context, f *file.AssetFile) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if !coin.IsEVM(f.Chain().ID) {
-		return nil
+		return nil, nil
 	}
 
 	assetDir := filepath.Base(f.Path())
@@ -34,29 +175,174 @@ func (s *Service) FixETHAddressChecksum(f *file.AssetFile) error {
 	if err != nil {
 		checksum, e := address.EIP55Checksum(assetDir)
 		if e != nil {
-			return fmt.Errorf("failed to get checksum: %s", e)
+			return nil, newFixError(f, ActionRenamed, fmt.Errorf("failed to get checksum: %s", e))
+		}
+
+		return s.renameAssetFolder(f, checksum)
+	}
+
+	return nil, nil
+}
+
+// FixAllEVMChecksums renames all asset folders of the EVM chain to their EIP-55 checksum addresses.
+func (s *Service) FixAllEVMChecksums(ctx context.Context, chainHandle string) (renamed int, err error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	chain, err := coin.GetCoinForId(chainHandle)
+	if err != nil {
+		return 0, err
+	}
+
+	if !coin.IsEVM(chain.ID) {
+		return 0, fmt.Errorf("%s is not an EVM chain", chainHandle)
+	}
+
+	// Asset folders don't contain nested asset folders, so a sorted pass has no rename conflicts.
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return 0, err
+	}
+
+	compErr := validation.NewErrComposite()
+
+	for _, assetID := range assetIDs {
+		if err := ctx.Err(); err != nil {
+			return renamed, err
+		}
+
+		if validation.ValidateETHForkAddress(chain, assetID) == nil {
+			continue
+		}
+
+		f := s.fileService.GetAssetFile(fmt.Sprintf("./%s", path.GetAssetPath(chainHandle, assetID)))
+
+		result, e := s.FixETHAddressChecksum(ctx, f)
+		if e != nil {
+			compErr.Append(e)
+			continue
 		}
 
-		newName := path.GetAssetPath(f.Chain().Handle, checksum)
+		if result != nil {
+			renamed++
+		}
+	}
+
+	if compErr.Len() > 0 {
+		return renamed, compErr
+	}
+
+	return renamed, nil
+}
+
+func (s *Service) FixSolanaAddress(ctx context.Context, f *file.AssetFile) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if f.Chain().ID != coin.SOLANA {
+		return nil, nil
+	}
+
+	assetDir := filepath.Base(f.Path())
+
+	key, err := base58.Decode(assetDir)
+	if err != nil {
+		return nil, newFixError(f, ActionRenamed, fmt.Errorf("%w: %s", validation.ErrInvalidAddress, err))
+	}
+
+	if len(key) != solanaPublicKeyLength {
+		return nil, newFixError(f, ActionRenamed, fmt.Errorf("%w: public key length should be %d, given %d",
+			validation.ErrInvalidAddress, solanaPublicKeyLength, len(key)))
+	}
+
+	if canonical := base58.Encode(key); canonical != assetDir {
+		return s.renameAssetFolder(f, canonical)
+	}
+
+	return nil, nil
+}
 
-		if e = os.Rename(f.Path(), newName); e != nil {
-			return fmt.Errorf("failed to rename dir: %s", e)
+func (s *Service) renameAssetFolder(f *file.AssetFile, newAssetDir string) (*Result, error) {
+	assetDir := filepath.Base(f.Path())
+	newName := path.GetAssetPath(f.Chain().Handle, newAssetDir)
+	result := &Result{Path: newName, Action: ActionRenamed, Before: assetDir, After: newAssetDir}
+
+	return s.applyFix(f, result, func() error {
+		s.renameMu.Lock()
+		defer s.renameMu.Unlock()
+
+		if err := os.Rename(f.Path(), newName); err != nil {
+			return fmt.Errorf("failed to rename dir: %s", err)
 		}
 
-		s.fileService.UpdateFile(f, checksum)
+		s.fileService.UpdateFile(f, newAssetDir)
 
 		log.WithField("from", assetDir).
-			WithField("to", checksum).
+			WithField("to", newAssetDir).
 			Debug("Renamed asset")
-	}
 
-	return nil
+		return nil
+	})
 }
 
-func (s *Service) FixLogo(f *file.AssetFile) error {
-	width, height, err := image.GetPNGImageDimensions(f.Path())
+func (s *Service) FixLogo(ctx context.Context, f *file.AssetFile) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	width, height, format, err := image.GetImageDimensions(f.Path())
 	if err != nil {
-		return err
+		return nil, newFixError(f, ActionResized, err)
+	}
+
+	var result *Result
+
+	if format != image.FormatPNG {
+		log.WithField("path", f.Path()).WithField("format", format).Debug("Converting logo to png")
+
+		result, err = s.applyFix(f, &Result{Path: f.Path(), Action: ActionConverted, Before: format, After: image.FormatPNG},
+			func() error { return image.ConvertToPNG(f.Path()) })
+		if err != nil {
+			return nil, err
+		}
+
+		// In dry-run the logo is not converted, following steps read it as PNG.
+		if s.dryRun {
+			return result, nil
+		}
+
+		// Rendered SVG images are sized by the rasterizer, not by the view box.
+		if format == image.FormatSVG {
+			if width, height, _, err = image.GetImageDimensions(f.Path()); err != nil {
+				return nil, newFixError(f, ActionResized, err)
+			}
+		}
+	}
+
+	// Palette is expanded before resizing, so the resized image is not quantized to the palette.
+	isIndexed, err := image.IsIndexedPNG(f.Path())
+	if err != nil {
+		return nil, newFixError(f, ActionConverted, err)
+	}
+
+	if isIndexed {
+		rgbaResult, err := s.applyFix(f, &Result{
+			Path:   f.Path(),
+			Action: ActionConverted,
+			Before: image.ColorModeIndexed,
+			After:  image.ColorModeRGBA,
+		}, func() error { return image.ConvertToRGBA(f.Path(), validation.ValidateLogoStreamSize) })
+		switch {
+		case errors.Is(err, image.ErrRGBATooLarge):
+			// Heavy logos are kept indexed, compression of the expanded image may not fit the size limit.
+			log.WithField("path", f.Path()).WithError(err).Warn("Logo is kept with indexed colors")
+		case err != nil:
+			return nil, err
+		default:
+			result = rgbaResult
+		}
 	}
 
 	var isLogoTooLarge bool
@@ -69,18 +355,49 @@ func (s *Service) FixLogo(f *file.AssetFile) error {
 
 		targetW, targetH := calculateTargetDimension(width, height)
 
-		err = image.ResizePNG(f.Path(), targetW, targetH)
+		result, err = s.applyFix(f, &Result{
+			Path:   f.Path(),
+			Action: ActionResized,
+			Before: fmt.Sprintf("%dx%d", width, height),
+			After:  fmt.Sprintf("%dx%d", targetW, targetH),
+		}, func() error { return imageLib.ResizePNG(f.Path(), targetW, targetH) })
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	err = validation.ValidateLogoFileSize(f.Path())
-	if err != nil { // nolint:staticcheck
-		// TODO: Compress images.
+	// Metadata is stripped after resizing, the resized image is checked by size validation below.
+	hasMetadata, err := image.HasMetadata(f.Path())
+	if err != nil {
+		return nil, newFixError(f, ActionStripped, err)
 	}
 
-	return nil
+	if hasMetadata {
+		stripResult, err := s.applyFix(f, &Result{Path: f.Path(), Action: ActionStripped},
+			func() error { return image.StripMetadata(f.Path()) })
+		switch {
+		case errors.Is(err, image.ErrNotSmaller):
+			log.WithField("path", f.Path()).WithError(err).Debug("Logo metadata is kept")
+		case err != nil:
+			// Stripping only reduces size, logos which don't decode are left for size validation below.
+			log.WithField("path", f.Path()).WithError(err).Warn("Failed to strip logo metadata")
+		default:
+			result = stripResult
+		}
+	}
+
+	if err = validation.ValidateLogoFileSize(f.Path()); err != nil {
+		log.WithField("path", f.Path()).Debug("Compressing too heavy image")
+
+		result, err = s.applyFix(f, &Result{Path: f.Path(), Action: ActionCompressed}, func() error {
+			return image.CompressPNG(f.Path(), validation.ValidateLogoStreamSize)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
 
 func calculateTargetDimension(width, height int) (targetW, targetH int) {
@@ -100,74 +417,738 @@ func calculateTargetDimension(width, height int) (targetW, targetH int) {
 	return targetW, targetH
 }
 
-func (s *Service) FixChainInfoJSON(f *file.AssetFile) error {
+func (s *Service) FixChainInfoJSON(ctx context.Context, f *file.AssetFile) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	chainInfo := info.CoinModel{}
 
 	err := fileLib.ReadJSONFile(f.Path(), &chainInfo)
 	if err != nil {
-		return err
+		return nil, newFixError(f, ActionUpdated, err)
 	}
 
+	var isModified bool
+
 	expectedType := string(types.Coin)
 	if chainInfo.Type == nil || *chainInfo.Type != expectedType {
 		chainInfo.Type = &expectedType
+		isModified = true
+	}
 
-		return fileLib.CreateJSONFile(f.Path(), &chainInfo)
+	// Fix chain status casing.
+	if chainInfo.Status != nil {
+		expectedStatus := strings.ToLower(*chainInfo.Status)
+		if *chainInfo.Status != expectedStatus {
+			chainInfo.Status = &expectedStatus
+			isModified = true
+		}
 	}
 
-	return nil
+	if !isModified {
+		return nil, nil
+	}
+
+	return s.applyFix(f, &Result{Path: f.Path(), Action: ActionUpdated}, func() error {
+		return s.createJSONFile(f.Path(), &chainInfo)
+	})
 }
 
-func (s *Service) FixAssetInfoJSON(file *file.AssetFile) error {
-	assetInfo := info.AssetModel{}
+// FixAllChainInfoJSONs fixes info files of all chains in a single walk of the chains folder. Errors of files
+// don't stop the walk, they are returned together.
+func (s *Service) FixAllChainInfoJSONs(ctx context.Context) (fixed int, err error) {
+	if err = ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	compErr := validation.NewErrComposite()
+
+	err = filepath.WalkDir(chainsPath, func(walkPath string, d fs.DirEntry, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		if err != nil {
+			compErr.Append(err)
+			return nil
+		}
+
+		// Chain info files are at blockchains/<chain>/info/info.json, other chain folders are skipped.
+		parts := strings.Split(filepath.ToSlash(walkPath), "/")
+		if d.IsDir() {
+			if len(parts) > 3 || len(parts) == 3 && parts[2] != "info" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if len(parts) != 4 || parts[2] != "info" || parts[3] != "info.json" {
+			return nil
+		}
+
+		f := s.fileService.GetAssetFile(fmt.Sprintf("./%s", filepath.ToSlash(walkPath)))
+
+		result, e := s.FixChainInfoJSON(ctx, f)
+		if e != nil {
+			compErr.Append(e)
+			return nil
+		}
 
-	err := fileLib.ReadJSONFile(file.Path(), &assetInfo)
+		if result != nil {
+			fixed++
+		}
+
+		return nil
+	})
 	if err != nil {
-		return err
+		return fixed, err
 	}
 
-	var isModified bool
+	if compErr.Len() > 0 {
+		return fixed, compErr
+	}
+
+	return fixed, nil
+}
+
+func (s *Service) FixAssetInfoJSON(ctx context.Context, file *file.AssetFile) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	assetInfo := info.AssetModel{}
+
+	// Decimals stored as a string are fixed before decoding, the model fails to decode them.
+	isModified, err := readAssetInfoWithStringDecimals(file.Path(), &assetInfo)
+	if err != nil {
+		return nil, newFixError(file, ActionUpdated, err)
+	}
 
 	// Fix asset type.
+	if fixAssetType(file, &assetInfo) {
+		isModified = true
+	}
+
+	// Fix asset id.
+	assetID := file.Asset()
+	if assetInfo.ID == nil || *assetInfo.ID != assetID {
+		assetInfo.ID = &assetID
+		isModified = true
+	}
+
+	// Fix asset explorer url, urls of other allowed explorers are kept.
+	expectedExplorerURL, err := s.getExplorerURL(file.Chain(), file.Asset())
+	if err != nil {
+		return nil, newFixError(file, ActionUpdated, err)
+	}
+
+	if assetInfo.Explorer == nil || !strings.EqualFold(expectedExplorerURL, *assetInfo.Explorer) &&
+		!s.hasAllowedExplorerPrefix(file.Chain(), *assetInfo.Explorer) {
+		assetInfo.Explorer = &expectedExplorerURL
+		isModified = true
+	}
+
+	// Fix asset decimals and symbol case.
+	if s.fixAssetDecimals(ctx, file, &assetInfo) {
+		isModified = true
+	}
+
+	if s.fixAssetSymbol(ctx, file, &assetInfo) {
+		isModified = true
+	}
+
+	// Add coingecko link.
+	if s.fixCoinGeckoLink(ctx, file, &assetInfo) {
+		isModified = true
+	}
+
+	// Migrate links to top-level fields.
+	if migrateLegacyLinks(&assetInfo) {
+		isModified = true
+	}
+
+	// Fix asset name whitespace, website url and tags.
+	if normalizeAssetInfoFields(&assetInfo) {
+		isModified = true
+	}
+
+	// Move legacy top-level links to links, other fields unknown to the asset model are dropped.
+	unknownFields, err := unknownJSONFields(file.Path(), assetInfo)
+	if err != nil {
+		return nil, newFixError(file, ActionUpdated, err)
+	}
+
+	if migrateLegacyLinkFields(&assetInfo, unknownFields) {
+		isModified = true
+	}
+
+	if len(unknownFields) > 0 {
+		log.WithField("path", file.Path()).
+			WithField("fields", len(unknownFields)).
+			Debug("Dropped fields unknown to the asset model")
+
+		isModified = true
+	}
+
+	if !isModified {
+		return nil, nil
+	}
+
+	return s.applyFix(file, &Result{Path: file.Path(), Action: ActionUpdated}, func() error {
+		return s.createJSONFile(file.Path(), &assetInfo)
+	})
+}
+
+// readAssetInfoWithStringDecimals decodes asset info file, decimals encoded as a JSON string are parsed to
+// a number. Reports whether decimals were a string.
+func readAssetInfoWithStringDecimals(path string, assetInfo *info.AssetModel) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return false, err
+	}
+
+	var decimals string
+	if json.Unmarshal(fields["decimals"], &decimals) != nil {
+		return false, json.Unmarshal(data, assetInfo)
+	}
+
+	value, err := strconv.Atoi(strings.TrimSpace(decimals))
+	if err != nil {
+		return false, fmt.Errorf("%w: decimals '%s' is not a number", validation.ErrInvalidField, decimals)
+	}
+
+	fields["decimals"] = json.RawMessage(strconv.Itoa(value))
+
+	if data, err = json.Marshal(fields); err != nil {
+		return false, err
+	}
+
+	log.WithField("path", path).WithField("decimals", decimals).Debug("Decimals are stored as a string")
+
+	return true, json.Unmarshal(data, assetInfo)
+}
+
+// getExplorerURL returns asset explorer url. Templates registered for non-EVM chains take precedence.
+func (s *Service) getExplorerURL(chain coin.Coin, assetID string) (string, error) {
+	if template, ok := s.explorerTemplates[chain.Handle]; ok && !coin.IsEVM(chain.ID) {
+		return strings.ReplaceAll(template, explorerAddressPlaceholder, assetID), nil
+	}
+
+	return coin.GetCoinExploreURL(chain, assetID)
+}
+
+func fixAssetType(f *file.AssetFile, assetInfo *info.AssetModel) bool {
 	var assetType string
 	if assetInfo.Type != nil {
 		assetType = *assetInfo.Type
 	}
 
+	expectedTokenType, ok := expectedAssetType(f, assetType)
+	if ok {
+		return false
+	}
+
+	log.WithField("path", f.Path()).
+		WithField("before", assetType).
+		WithField("after", expectedTokenType).
+		Info("Fixed asset type")
+
+	assetInfo.Type = &expectedTokenType
+
+	return true
+}
+
+// expectedAssetType returns the token type expected for the asset, and reports whether the given type is correct.
+func expectedAssetType(f *file.AssetFile, assetType string) (string, bool) {
 	// We need to skip error check to fix asset type if it's incorrect or empty.
 	chain, _ := types.GetChainFromAssetType(assetType)
 
-	expectedTokenType, ok := types.GetTokenType(file.Chain().ID, file.Asset())
+	expectedTokenType, ok := types.GetTokenType(f.Chain().ID, f.Asset())
 	if !ok {
 		expectedTokenType = strings.ToUpper(assetType)
 	}
 
-	if chain.ID != file.Chain().ID || !strings.EqualFold(assetType, expectedTokenType) {
-		assetInfo.Type = &expectedTokenType
+	// Wrapped tokens may have the token type of the original chain, e.g. ERC20 on Smart Chain.
+	isForeignType := chain.ID != f.Chain().ID
+	if !isForeignType && strings.EqualFold(assetType, expectedTokenType) || assetType == expectedTokenType {
+		if isForeignType && assetType != "" {
+			log.WithField("path", f.Path()).WithField("type", assetType).
+				Warn("Asset type belongs to another chain, but token type of the chain is unknown")
+		}
+
+		return expectedTokenType, true
+	}
+
+	return expectedTokenType, false
+}
+
+func (s *Service) FixTokenList(ctx context.Context, f *file.AssetFile) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var tokenList TokenList
+	if err := fileLib.ReadJSONFile(f.Path(), &tokenList); err != nil {
+		return nil, newFixError(f, ActionUpdated, err)
+	}
+
+	var fixedCounter int
+
+	filteredTokens, removed := dedupeTokens(f, tokenList.Tokens)
+	fixedCounter += removed
+
+	if sortTokens(filteredTokens) {
+		fixedCounter++
+	}
+
+	fixedCounter += s.fixTokenLogoURIs(f, filteredTokens)
+	fixedCounter += fixTokenDecimals(f, filteredTokens)
+
+	tokenList.Tokens = filteredTokens
+
+	if fixedCounter == 0 {
+		return nil, nil
+	}
+
+	return s.applyFix(f, &Result{Path: f.Path(), Action: ActionUpdated}, func() error {
+		return s.createJSONFile(f.Path(), &tokenList)
+	})
+}
+
+// fixTokenLogoURIs sets canonical logo urls for tokens which have a local logo, but an empty or
+// different logoURI. Returns the number of updated tokens.
+func (s *Service) fixTokenLogoURIs(f *file.AssetFile, tokens []TokenItem) int {
+	if s.logoURLTemplate == "" {
+		return 0
+	}
+
+	var fixed int
+
+	for i := range tokens {
+		assetID := tokens[i].Address
+		if assetID == "" || !fileLib.FileExists(path.GetAssetLogoPath(f.Chain().Handle, assetID)) {
+			continue
+		}
+
+		logoURI := s.tokenLogoURL(f.Chain().Handle, assetID)
+		if tokens[i].LogoURI != logoURI {
+			log.WithField("path", f.Path()).
+				WithField("address", assetID).
+				WithField("logoURI", logoURI).
+				Debug("Updated token logo url")
+
+			tokens[i].LogoURI = logoURI
+			fixed++
+		}
+	}
+
+	return fixed
+}
+
+// tokenLogoURL returns the logo url of the asset by the template set by WithLogoURLTemplate.
+func (s *Service) tokenLogoURL(chainHandle, assetID string) string {
+	return strings.NewReplacer(
+		logoChainPlaceholder, chainHandle,
+		logoAssetPlaceholder, assetID,
+	).Replace(s.logoURLTemplate)
+}
+
+// FixTokenListLogoURIs rebuilds logo urls of all tokens of the chain token list from the template with two %s verbs,
+// which are replaced with the chain handle and the token address. Native coin entries use the chain logo and are kept.
+func (s *Service) FixTokenListLogoURIs(ctx context.Context, chainHandle, baseURLTemplate string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if strings.Count(baseURLTemplate, "%s") != 2 || strings.Count(baseURLTemplate, "%") != 2 {
+		return fmt.Errorf("logo url template should have exactly two %%s verbs: %s", baseURLTemplate)
+	}
+
+	f := s.fileService.GetAssetFile(fmt.Sprintf("./%s", path.GetTokenListPath(chainHandle)))
+
+	var tokenList TokenList
+	if err := fileLib.ReadJSONFile(f.Path(), &tokenList); err != nil {
+		return err
+	}
+
+	var fixed int
+
+	for i := range tokenList.Tokens {
+		if tokenList.Tokens[i].Type == types.Coin {
+			continue
+		}
+
+		logoURI := fmt.Sprintf(baseURLTemplate, chainHandle, tokenList.Tokens[i].Address)
+		if tokenList.Tokens[i].LogoURI != logoURI {
+			tokenList.Tokens[i].LogoURI = logoURI
+			fixed++
+		}
+	}
+
+	if fixed == 0 {
+		return nil
+	}
+
+	log.WithField("path", f.Path()).WithField("tokens", fixed).Debug("Updated token logo urls")
+
+	_, err := s.applyFix(f, &Result{Path: f.Path(), Action: ActionUpdated}, func() error {
+		return s.createJSONFile(f.Path(), &tokenList)
+	})
+
+	return err
+}
+
+// FixTokenListTimestamp sets the token list timestamp to the current UTC time. The file is always rewritten,
+// so the timestamp is current after an automated fix run.
+func (s *Service) FixTokenListTimestamp(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var tokenList TokenList
+	if err := fileLib.ReadJSONFile(f.Path(), &tokenList); err != nil {
+		return err
+	}
+
+	timestamp := time.Now().UTC().Format(timestampFormat)
+	result := &Result{Path: f.Path(), Action: ActionUpdated, Before: tokenList.Timestamp, After: timestamp}
+
+	tokenList.Timestamp = timestamp
+
+	_, err := s.applyFix(f, result, func() error {
+		return s.createJSONFile(f.Path(), &tokenList)
+	})
+
+	return err
+}
+
+// fixTokenDecimals sets decimals of tokens to the values of their asset info files, which are the source of truth.
+// Returns the number of updated tokens.
+func fixTokenDecimals(f *file.AssetFile, tokens []TokenItem) int {
+	var fixed int
+
+	for i := range tokens {
+		assetInfoPath := path.GetAssetInfoPath(f.Chain().Handle, tokens[i].Address)
+		if tokens[i].Address == "" || !fileLib.FileExists(assetInfoPath) {
+			continue
+		}
+
+		var assetInfo info.AssetModel
+		if err := fileLib.ReadJSONFile(assetInfoPath, &assetInfo); err != nil || assetInfo.Decimals == nil {
+			continue
+		}
+
+		decimals := *assetInfo.Decimals
+		if decimals < 0 || uint(decimals) == tokens[i].Decimals {
+			continue
+		}
+
+		log.WithField("path", f.Path()).
+			WithField("address", tokens[i].Address).
+			WithField("before", tokens[i].Decimals).
+			WithField("after", decimals).
+			Warn("Token decimals differ from asset info")
+
+		tokens[i].Decimals = uint(decimals)
+		fixed++
+	}
+
+	return fixed
+}
+
+// dedupeTokens keeps the first token for each address. EVM addresses are compared case-insensitively.
+func dedupeTokens(f *file.AssetFile, tokens []TokenItem) ([]TokenItem, int) {
+	isEVM := coin.IsEVM(f.Chain().ID)
+	seen := make(map[string]struct{}, len(tokens))
+	result := make([]TokenItem, 0, len(tokens))
+
+	for _, token := range tokens {
+		key := token.Address
+		if isEVM {
+			key = strings.ToLower(key)
+		}
+
+		if _, ok := seen[key]; ok && key != "" {
+			log.WithField("path", f.Path()).
+				WithField("address", token.Address).
+				Warn("Removed duplicate token from token list")
+
+			continue
+		}
+
+		seen[key] = struct{}{}
+		result = append(result, token)
+	}
+
+	return result, len(tokens) - len(result)
+}
+
+// fixAssetDecimals fetches missing decimals of EVM tokens from the chain.
+func (s *Service) fixAssetDecimals(ctx context.Context, f *file.AssetFile, assetInfo *info.AssetModel) bool {
+	if assetInfo.Decimals != nil && *assetInfo.Decimals != 0 {
+		return false
+	}
+
+	if !coin.IsEVM(f.Chain().ID) || !s.hasRPCEndpoint(f.Chain()) {
+		return false
+	}
+
+	decimals, err := s.fetchTokenDecimals(ctx, f.Chain(), f.Asset())
+	if err != nil {
+		log.WithError(err).WithField("path", f.Path()).Warn("Failed to fetch token decimals")
+
+		return false
+	}
+
+	if assetInfo.Decimals != nil && *assetInfo.Decimals == decimals {
+		return false
+	}
+
+	assetInfo.Decimals = &decimals
+
+	return true
+}
+
+// migrateLegacyLinks moves links which have top-level counterparts out of the links array.
+// Top-level fields which are already populated are never overwritten, such links are kept.
+func migrateLegacyLinks(assetInfo *info.AssetModel) bool {
+	fields := map[string]**string{
+		linkNameWebsite:       &assetInfo.Website,
+		linkNameTwitter:       &assetInfo.Twitter,
+		linkNameCoinMarketCap: &assetInfo.CoinMarketcap,
+	}
+
+	var isModified bool
+
+	links := make([]info.Link, 0, len(assetInfo.Links))
+	for _, link := range assetInfo.Links {
+		if link.Name == nil || link.URL == nil {
+			links = append(links, link)
+			continue
+		}
+
+		field, ok := fields[*link.Name]
+		if !ok || (*field != nil && **field != "") {
+			links = append(links, link)
+			continue
+		}
+
+		*field = link.URL
 		isModified = true
 	}
 
-	// Fix asset id.
-	assetID := file.Asset()
-	if assetInfo.ID == nil || *assetInfo.ID != assetID {
-		assetInfo.ID = &assetID
+	if !isModified {
+		return false
+	}
+
+	assetInfo.Links = links
+	if len(links) == 0 {
+		assetInfo.Links = nil
+	}
+
+	return true
+}
+
+// migrateLegacyLinkFields moves top-level fields listed in legacyLinkFields to links, migrated fields are deleted
+// from the unknown fields. "www." is dropped from link hosts. Fields of links which are already present and fields
+// which are not valid links are left in the unknown fields.
+func migrateLegacyLinkFields(assetInfo *info.AssetModel, unknownFields map[string]json.RawMessage) bool {
+	names := make([]string, 0, len(unknownFields))
+	for name := range unknownFields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var isModified bool
+
+	for _, name := range names {
+		linkName, ok := legacyLinkFields[strings.ToLower(name)]
+		if !ok || hasLink(assetInfo, linkName) {
+			continue
+		}
+
+		var linkURL string
+		if json.Unmarshal(unknownFields[name], &linkURL) != nil {
+			continue
+		}
+
+		linkURL = strings.Replace(strings.TrimSpace(linkURL), "https://www.", "https://", 1)
+
+		link := info.Link{Name: &linkName, URL: &linkURL}
+		if info.ValidateLinks([]info.Link{link}) != nil {
+			continue
+		}
+
+		assetInfo.Links = append(assetInfo.Links, link)
+
+		delete(unknownFields, name)
+
 		isModified = true
 	}
 
-	expectedExplorerURL, err := coin.GetCoinExploreURL(file.Chain(), file.Asset())
+	return isModified
+}
+
+// fixAssetSymbol fixes case of EVM token symbol according to the contract. Contract errors are ignored,
+// because non-standard contracts may not implement symbol().
+func (s *Service) fixAssetSymbol(ctx context.Context, f *file.AssetFile, assetInfo *info.AssetModel) bool {
+	if assetInfo.Symbol == nil || !coin.IsEVM(f.Chain().ID) || !s.hasRPCEndpoint(f.Chain()) {
+		return false
+	}
+
+	symbol, err := s.fetchTokenSymbol(ctx, f.Chain(), f.Asset())
 	if err != nil {
-		return err
+		log.WithError(err).WithField("path", f.Path()).Debug("Failed to fetch token symbol")
+
+		return false
 	}
 
-	// Fix asset explorer url.
-	if assetInfo.Explorer == nil || !strings.EqualFold(expectedExplorerURL, *assetInfo.Explorer) {
-		assetInfo.Explorer = &expectedExplorerURL
+	if symbol == *assetInfo.Symbol || !strings.EqualFold(symbol, *assetInfo.Symbol) {
+		return false
+	}
+
+	assetInfo.Symbol = &symbol
+
+	return true
+}
+
+func normalizeAssetInfoFields(assetInfo *info.AssetModel) bool {
+	var isModified bool
+
+	if assetInfo.Name != nil {
+		name := normalizeWhitespace(*assetInfo.Name)
+		if name != *assetInfo.Name {
+			assetInfo.Name = &name
+			isModified = true
+		}
+	}
+
+	if assetInfo.Website != nil {
+		website := normalizeWebsiteURL(*assetInfo.Website)
+		if website != *assetInfo.Website {
+			assetInfo.Website = &website
+			isModified = true
+		}
+	}
+
+	// Tags are lower-cased, deduplicated and sorted.
+	if tags := normalizeTags(assetInfo.Tags); !equalStrings(tags, assetInfo.Tags) {
+		assetInfo.Tags = tags
 		isModified = true
 	}
 
-	if isModified {
-		return fileLib.CreateJSONFile(file.Path(), &assetInfo)
+	return isModified
+}
+
+// normalizeWhitespace trims the value and collapses any unicode whitespace runs into a single space.
+func normalizeWhitespace(value string) string {
+	return strings.Join(strings.FieldsFunc(value, unicode.IsSpace), " ")
+}
+
+// normalizeTags returns lower-cased tags without duplicates in alphabetical order.
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return tags
+	}
+
+	seen := make(map[string]struct{}, len(tags))
+	result := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(tag)
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+
+		seen[tag] = struct{}{}
+		result = append(result, tag)
 	}
 
-	return nil
+	sort.Strings(result)
+
+	return result
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeWebsiteURL upgrades http scheme to https, lower-cases host and strips trailing slashes.
+func normalizeWebsiteURL(website string) string {
+	u, err := url.Parse(website)
+	if err != nil || u.Host == "" {
+		return website
+	}
+
+	if u.Scheme == "http" {
+		u.Scheme = "https"
+	}
+
+	u.Host = strings.ToLower(u.Host)
+
+	if u.RawQuery == "" && u.Fragment == "" {
+		u.Path = strings.TrimRight(u.Path, "/")
+		u.RawPath = strings.TrimRight(u.RawPath, "/")
+	}
+
+	return u.String()
+}
+
+// unknownJSONFields returns top-level fields of the JSON object in the file
+// that are not declared by json tags of the model struct.
+func unknownJSONFields(path string, model interface{}) (map[string]json.RawMessage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(bytes.TrimPrefix(data, utf8BOM), &fields); err != nil {
+		return nil, err
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(model))
+	for name := range fields {
+		if _, ok := known[name]; ok {
+			delete(fields, name)
+		}
+	}
+
+	return fields, nil
+}
+
+func jsonFieldNames(t reflect.Type) map[string]struct{} {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	names := make(map[string]struct{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		names[name] = struct{}{}
+	}
+
+	return names
 }
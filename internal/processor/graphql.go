@@ -0,0 +1,377 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultGraphQLCacheTTL is used when cache TTL of GraphQL resolvers is not set.
+const defaultGraphQLCacheTTL = time.Minute
+
+type (
+	graphQLRequest struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+
+	// graphQLCache keeps values read from files by resolvers until the TTL expires.
+	graphQLCache struct {
+		mu      sync.Mutex
+		ttl     time.Duration
+		entries map[string]graphQLCacheEntry
+	}
+
+	graphQLCacheEntry struct {
+		value   interface{}
+		expires time.Time
+	}
+)
+
+var (
+	graphQLLinkType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Link",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+			"url":  &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	graphQLAssetInfoType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "AssetInfo",
+		Fields: graphql.Fields{
+			"id":          &graphql.Field{Type: graphql.String},
+			"name":        &graphql.Field{Type: graphql.String},
+			"symbol":      &graphql.Field{Type: graphql.String},
+			"type":        &graphql.Field{Type: graphql.String},
+			"decimals":    &graphql.Field{Type: graphql.Int},
+			"description": &graphql.Field{Type: graphql.String},
+			"website":     &graphql.Field{Type: graphql.String},
+			"explorer":    &graphql.Field{Type: graphql.String},
+			"status":      &graphql.Field{Type: graphql.String},
+			"tags":        &graphql.Field{Type: graphql.NewList(graphql.String)},
+			"links":       &graphql.Field{Type: graphql.NewList(graphQLLinkType)},
+		},
+	})
+
+	graphQLChainInfoType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "ChainInfo",
+		Fields: graphql.Fields{
+			"handle":      &graphql.Field{Type: graphql.String},
+			"name":        &graphql.Field{Type: graphql.String},
+			"symbol":      &graphql.Field{Type: graphql.String},
+			"type":        &graphql.Field{Type: graphql.String},
+			"decimals":    &graphql.Field{Type: graphql.Int},
+			"description": &graphql.Field{Type: graphql.String},
+			"website":     &graphql.Field{Type: graphql.String},
+			"explorer":    &graphql.Field{Type: graphql.String},
+			"status":      &graphql.Field{Type: graphql.String},
+			"tags":        &graphql.Field{Type: graphql.NewList(graphql.String)},
+			"links":       &graphql.Field{Type: graphql.NewList(graphQLLinkType)},
+		},
+	})
+
+	graphQLTokenType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Token",
+		Fields: graphql.Fields{
+			"asset":    &graphql.Field{Type: graphql.String},
+			"type":     &graphql.Field{Type: graphql.String},
+			"address":  &graphql.Field{Type: graphql.String},
+			"name":     &graphql.Field{Type: graphql.String},
+			"symbol":   &graphql.Field{Type: graphql.String},
+			"decimals": &graphql.Field{Type: graphql.Int},
+			"logoURI":  &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	graphQLVersionType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Version",
+		Fields: graphql.Fields{
+			"major": &graphql.Field{Type: graphql.Int},
+			"minor": &graphql.Field{Type: graphql.Int},
+			"patch": &graphql.Field{Type: graphql.Int},
+		},
+	})
+
+	graphQLTokenListType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "TokenList",
+		Fields: graphql.Fields{
+			"name":      &graphql.Field{Type: graphql.String},
+			"logoURI":   &graphql.Field{Type: graphql.String},
+			"timestamp": &graphql.Field{Type: graphql.String},
+			"version":   &graphql.Field{Type: graphQLVersionType},
+			"tokens":    &graphql.Field{Type: graphql.NewList(graphQLTokenType)},
+		},
+	})
+)
+
+// GraphQLHandler serves asset info, chain info and token list files over GraphQL, with the queries
+// asset(chain, address), chain(handle) and tokenList(chain). Queries are accepted as GET "query" parameter or
+// POST JSON body. Files are read on request and cached for the TTL set by WithGraphQLCacheTTL.
+func (s *Service) GraphQLHandler() http.Handler {
+	cache := &graphQLCache{ttl: s.graphQLCacheTTL, entries: make(map[string]graphQLCacheEntry)}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"asset": &graphql.Field{
+					Type: graphQLAssetInfoType,
+					Args: graphql.FieldConfigArgument{
+						"chain":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+						"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						chain, address := p.Args["chain"].(string), p.Args["address"].(string)
+
+						return cache.get("asset/"+chain+"/"+address, func() (interface{}, error) {
+							return resolveGraphQLAsset(p.Context, chain, address)
+						})
+					},
+				},
+				"chain": &graphql.Field{
+					Type: graphQLChainInfoType,
+					Args: graphql.FieldConfigArgument{
+						"handle": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						handle := p.Args["handle"].(string)
+
+						return cache.get("chain/"+handle, func() (interface{}, error) {
+							return resolveGraphQLChain(p.Context, handle)
+						})
+					},
+				},
+				"tokenList": &graphql.Field{
+					Type: graphQLTokenListType,
+					Args: graphql.FieldConfigArgument{
+						"chain": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						chain := p.Args["chain"].(string)
+
+						return cache.get("tokenlist/"+chain, func() (interface{}, error) {
+							return resolveGraphQLTokenList(p.Context, chain)
+						})
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, fmt.Sprintf("failed to build graphql schema: %s", err), http.StatusInternalServerError)
+		})
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+
+		switch r.Method {
+		case http.MethodGet:
+			req.Query = r.URL.Query().Get("query")
+			req.OperationName = r.URL.Query().Get("operationName")
+		case http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, fmt.Sprintf("failed to decode request: %s", err), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method is not allowed", http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  req.Query,
+			OperationName:  req.OperationName,
+			VariableValues: req.Variables,
+			Context:        r.Context(),
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			log.WithError(err).Debug("Failed to write graphql response")
+		}
+	})
+}
+
+func resolveGraphQLAsset(ctx context.Context, chain, address string) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !isPathSegment(chain) || !isPathSegment(address) {
+		return nil, fmt.Errorf("invalid chain or address")
+	}
+
+	assetInfoPath := path.GetAssetInfoPath(chain, address)
+	if !fileLib.FileExists(assetInfoPath) {
+		return nil, nil
+	}
+
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(assetInfoPath, &assetInfo); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"id":          stringValue(assetInfo.ID),
+		"name":        stringValue(assetInfo.Name),
+		"symbol":      stringValue(assetInfo.Symbol),
+		"type":        stringValue(assetInfo.Type),
+		"decimals":    assetInfo.Decimals,
+		"description": stringValue(assetInfo.Description),
+		"website":     stringValue(assetInfo.Website),
+		"explorer":    stringValue(assetInfo.Explorer),
+		"status":      stringValue(assetInfo.Status),
+		"tags":        assetInfo.Tags,
+		"links":       graphQLLinks(assetInfo.Links),
+	}, nil
+}
+
+func resolveGraphQLChain(ctx context.Context, handle string) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !isPathSegment(handle) {
+		return nil, fmt.Errorf("invalid chain handle")
+	}
+
+	chainInfoPath := getChainInfoPath(handle)
+	if !fileLib.FileExists(chainInfoPath) {
+		return nil, nil
+	}
+
+	var coinInfo info.CoinModel
+	if err := fileLib.ReadJSONFile(chainInfoPath, &coinInfo); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"handle":      handle,
+		"name":        stringValue(coinInfo.Name),
+		"symbol":      stringValue(coinInfo.Symbol),
+		"type":        stringValue(coinInfo.Type),
+		"decimals":    coinInfo.Decimals,
+		"description": stringValue(coinInfo.Description),
+		"website":     stringValue(coinInfo.Website),
+		"explorer":    stringValue(coinInfo.Explorer),
+		"status":      stringValue(coinInfo.Status),
+		"tags":        coinInfo.Tags,
+		"links":       graphQLLinks(coinInfo.Links),
+	}, nil
+}
+
+func resolveGraphQLTokenList(ctx context.Context, chain string) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !isPathSegment(chain) {
+		return nil, fmt.Errorf("invalid chain handle")
+	}
+
+	tokenListPath := path.GetTokenListPath(chain)
+	if !fileLib.FileExists(tokenListPath) {
+		return nil, nil
+	}
+
+	var tokenList TokenList
+	if err := fileLib.ReadJSONFile(tokenListPath, &tokenList); err != nil {
+		return nil, err
+	}
+
+	tokens := make([]map[string]interface{}, 0, len(tokenList.Tokens))
+	for _, token := range tokenList.Tokens {
+		tokens = append(tokens, map[string]interface{}{
+			"asset":    token.Asset,
+			"type":     string(token.Type),
+			"address":  token.Address,
+			"name":     token.Name,
+			"symbol":   token.Symbol,
+			"decimals": int(token.Decimals),
+			"logoURI":  token.LogoURI,
+		})
+	}
+
+	return map[string]interface{}{
+		"name":      tokenList.Name,
+		"logoURI":   tokenList.LogoURI,
+		"timestamp": tokenList.Timestamp,
+		"version": map[string]interface{}{
+			"major": tokenList.Version.Major,
+			"minor": tokenList.Version.Minor,
+			"patch": tokenList.Version.Patch,
+		},
+		"tokens": tokens,
+	}, nil
+}
+
+func graphQLLinks(links []info.Link) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(links))
+	for _, link := range links {
+		result = append(result, map[string]interface{}{
+			"name": stringValue(link.Name),
+			"url":  stringValue(link.URL),
+		})
+	}
+
+	return result
+}
+
+// isPathSegment reports whether the query argument is a single path segment, so it can't refer to files outside
+// of the chains folder.
+func isPathSegment(value string) bool {
+	return value != "" && value != "." && value != ".." && !strings.ContainsAny(value, `/\`)
+}
+
+// get returns the cached value of the key, or loads and caches it. Missing files are not cached, so the cache
+// holds at most one entry per file of the repo, and expired entries are dropped on the next store. Files are read
+// without holding the lock, concurrent requests of an expired key may load it more than once.
+func (c *graphQLCache) get(key string, load func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if ok && time.Now().Before(entry.expires) {
+		return entry.value, nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+
+	if c.ttl > 0 && value != nil {
+		c.entries[key] = graphQLCacheEntry{value: value, expires: now.Add(c.ttl)}
+	}
+
+	return value, nil
+}
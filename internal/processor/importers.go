@@ -0,0 +1,288 @@
+package processor
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	imageLib "github.com/trustwallet/assets-go-libs/image"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+	"github.com/trustwallet/assets/internal/image"
+	"github.com/trustwallet/go-primitives/coin"
+	"github.com/trustwallet/go-primitives/types"
+)
+
+// maxLogoDownloadSize limits size of downloaded logo images.
+const maxLogoDownloadSize = 10 << 20
+
+var assetsCSVColumns = []string{"address", "name", "symbol", "decimals", "website", "status"}
+
+// BulkImportAssets creates asset info files of the chain from CSV rows with columns listed in assetsCSVColumns.
+// Existing info files are skipped unless overwrite is set. Rows with invalid addresses or values are skipped
+// and reported in the returned error.
+func (s *Service) BulkImportAssets(
+	ctx context.Context, r io.Reader, chainHandle string, overwrite bool,
+) (imported, skipped int, err error) {
+	if err = ctx.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	chain, err := coin.GetCoinForId(chainHandle)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	columns, err := csvColumnIndexes(header, assetsCSVColumns)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	compErr := validation.NewErrComposite()
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return imported, skipped, err
+		}
+
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return imported, skipped, fmt.Errorf("failed to read csv row: %w", err)
+		}
+
+		assetInfo, err := s.newImportedAssetInfo(chain, row, columns)
+		if err != nil {
+			compErr.Append(err)
+			skipped++
+
+			continue
+		}
+
+		assetInfoPath := path.GetAssetInfoPath(chain.Handle, *assetInfo.ID)
+		if !overwrite && fileLib.FileExists(assetInfoPath) {
+			skipped++
+			continue
+		}
+
+		if err = fileLib.CreateDirPath(assetInfoPath); err != nil {
+			return imported, skipped, err
+		}
+
+		if err = s.createJSONFile(assetInfoPath, assetInfo); err != nil {
+			return imported, skipped, err
+		}
+
+		imported++
+	}
+
+	if compErr.Len() > 0 {
+		return imported, skipped, compErr
+	}
+
+	return imported, skipped, nil
+}
+
+// BackfillLogoFromURL downloads logos of existing asset folders without logo.png. Keys of urlMap are
+// "<chain>/<address>", values are image urls. Downloaded JPEG and WebP images are converted to PNG and resized
+// to fit the maximum logo dimensions, SVG images are rendered to PNG.
+// Failed downloads don't stop the backfill, they are returned together.
+func (s *Service) BackfillLogoFromURL(ctx context.Context, urlMap map[string]string) (downloaded int, err error) {
+	if err = ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	keys := make([]string, 0, len(urlMap))
+	for key := range urlMap {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	compErr := validation.NewErrComposite()
+
+	for _, key := range keys {
+		if err = ctx.Err(); err != nil {
+			return downloaded, err
+		}
+
+		parts := strings.SplitN(key, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			compErr.Append(fmt.Errorf("%s: key should be <chain>/<address>", key))
+			continue
+		}
+
+		chainHandle, assetID := parts[0], parts[1]
+		if !fileLib.FileExists(path.GetAssetPath(chainHandle, assetID)) {
+			continue
+		}
+
+		logoPath := path.GetAssetLogoPath(chainHandle, assetID)
+		if fileLib.FileExists(logoPath) {
+			continue
+		}
+
+		if err = s.downloadLogo(ctx, urlMap[key], logoPath); err != nil {
+			// Partially processed logos would be taken as existing by the next backfill.
+			_ = os.Remove(logoPath)
+
+			compErr.Append(fmt.Errorf("%s: %w", key, err))
+
+			continue
+		}
+
+		downloaded++
+	}
+
+	if compErr.Len() > 0 {
+		return downloaded, compErr
+	}
+
+	return downloaded, nil
+}
+
+// downloadLogo writes the image from the url to the logo path as a PNG image fitting the maximum logo dimensions.
+func (s *Service) downloadLogo(ctx context.Context, logoURL, logoPath string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logoURL, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = s.CheckRateLimit(ctx); err != nil {
+		return err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make GET request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxLogoDownloadSize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read logo: %w", err)
+	}
+
+	if len(data) > maxLogoDownloadSize {
+		return fmt.Errorf("logo is larger than %d bytes", maxLogoDownloadSize)
+	}
+
+	if err = os.WriteFile(logoPath, data, fileModeReadWrite); err != nil {
+		return err
+	}
+
+	if err = image.ConvertToPNG(logoPath); err != nil {
+		return err
+	}
+
+	width, height, _, err := image.GetImageDimensions(logoPath)
+	if err != nil {
+		return err
+	}
+
+	if width > validation.MaxW || height > validation.MaxH {
+		targetW, targetH := calculateTargetDimension(width, height)
+		if err = imageLib.ResizePNG(logoPath, targetW, targetH); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) newImportedAssetInfo(
+	chain coin.Coin, row []string, columns map[string]int,
+) (*info.AssetModel, error) {
+	address := strings.TrimSpace(row[columns["address"]])
+	if err := validation.ValidateAssetAddress(chain, address); err != nil {
+		return nil, fmt.Errorf("%s: %w", address, err)
+	}
+
+	decimals, err := strconv.Atoi(strings.TrimSpace(row[columns["decimals"]]))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: decimals", address, validation.ErrInvalidField)
+	}
+
+	assetInfo, err := s.newAssetInfo(chain, address, row[columns["name"]], row[columns["symbol"]], decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	if status := strings.ToLower(strings.TrimSpace(row[columns["status"]])); status != "" {
+		assetInfo.Status = &status
+	}
+
+	if website := normalizeWebsiteURL(strings.TrimSpace(row[columns["website"]])); website != "" {
+		assetInfo.Website = &website
+	}
+
+	return assetInfo, nil
+}
+
+// newAssetInfo builds info of an active asset with the explorer link and the token type of the chain.
+func (s *Service) newAssetInfo(
+	chain coin.Coin, address, name, symbol string, decimals int,
+) (*info.AssetModel, error) {
+	explorer, err := s.getExplorerURL(chain, address)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", address, err)
+	}
+
+	name = normalizeWhitespace(name)
+	symbol = strings.TrimSpace(symbol)
+	status := activeStatus
+
+	assetInfo := &info.AssetModel{
+		Name:     &name,
+		Symbol:   &symbol,
+		Decimals: &decimals,
+		Explorer: &explorer,
+		Status:   &status,
+		ID:       &address,
+	}
+
+	if tokenType, ok := types.GetTokenType(chain.ID, address); ok {
+		assetInfo.Type = &tokenType
+	}
+
+	return assetInfo, nil
+}
+
+// csvColumnIndexes maps required column names to their indexes in the header.
+func csvColumnIndexes(header, required []string) (map[string]int, error) {
+	indexes := make(map[string]int, len(header))
+	for i, name := range header {
+		indexes[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range required {
+		if _, ok := indexes[name]; !ok {
+			return nil, fmt.Errorf("missing csv column %s", name)
+		}
+	}
+
+	return indexes, nil
+}
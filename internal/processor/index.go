@@ -0,0 +1,137 @@
+package processor
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+)
+
+type (
+	// AssetRef points to an asset found by AssetIndex.
+	AssetRef struct {
+		Chain  string `json:"chain"`
+		ID     string `json:"id"`
+		Name   string `json:"name"`
+		Symbol string `json:"symbol"`
+	}
+
+	// AssetIndex is an inverted index of assets, keyed by lower-cased tokens of their names, symbols and IDs.
+	AssetIndex struct {
+		tokens map[string][]AssetRef
+	}
+)
+
+// GenerateAssetIndex reads info files of assets of all chains and builds a search index of them.
+func (s *Service) GenerateAssetIndex(ctx context.Context) (*AssetIndex, error) {
+	chains, err := getChainHandles()
+	if err != nil {
+		return nil, err
+	}
+
+	index := &AssetIndex{tokens: make(map[string][]AssetRef)}
+
+	for _, chain := range chains {
+		if !fileLib.FileExists(getChainAssetsPath(chain)) {
+			continue
+		}
+
+		assetIDs, err := getChainAssetIDs(chain)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assetID := range assetIDs {
+			if err = ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			infoPath := path.GetAssetInfoPath(chain, assetID)
+			if !fileLib.FileExists(infoPath) {
+				continue
+			}
+
+			var assetInfo info.AssetModel
+			if err = fileLib.ReadJSONFile(infoPath, &assetInfo); err != nil {
+				return nil, err
+			}
+
+			ref := AssetRef{
+				Chain:  chain,
+				ID:     assetID,
+				Name:   stringValue(assetInfo.Name),
+				Symbol: stringValue(assetInfo.Symbol),
+			}
+
+			index.add(ref, ref.Name, ref.Symbol, ref.ID)
+		}
+	}
+
+	return index, nil
+}
+
+// Search returns assets matching any token of the query. Assets matching more tokens come first, an exact symbol
+// match counts twice. Assets with the same score are sorted by chain and ID.
+func (i *AssetIndex) Search(query string) []AssetRef {
+	scores := make(map[AssetRef]int)
+	for _, token := range uniqueTokens(query) {
+		for _, ref := range i.tokens[token] {
+			scores[ref]++
+
+			if strings.ToLower(ref.Symbol) == token {
+				scores[ref]++
+			}
+		}
+	}
+
+	refs := make([]AssetRef, 0, len(scores))
+	for ref := range scores {
+		refs = append(refs, ref)
+	}
+
+	sort.Slice(refs, func(a, b int) bool {
+		if scores[refs[a]] != scores[refs[b]] {
+			return scores[refs[a]] > scores[refs[b]]
+		}
+
+		if refs[a].Chain != refs[b].Chain {
+			return refs[a].Chain < refs[b].Chain
+		}
+
+		return refs[a].ID < refs[b].ID
+	})
+
+	return refs
+}
+
+// add indexes the asset by tokens of the texts, the asset is added once per token.
+func (i *AssetIndex) add(ref AssetRef, texts ...string) {
+	for _, token := range uniqueTokens(texts...) {
+		i.tokens[token] = append(i.tokens[token], ref)
+	}
+}
+
+// uniqueTokens splits the texts to lower-cased runs of letters and digits, without repeats.
+func uniqueTokens(texts ...string) []string {
+	seen := make(map[string]bool)
+
+	var tokens []string
+	for _, text := range texts {
+		fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+
+		for _, field := range fields {
+			if !seen[field] {
+				seen[field] = true
+				tokens = append(tokens, field)
+			}
+		}
+	}
+
+	return tokens
+}
@@ -0,0 +1,67 @@
+package processor
+
+// stripJSONComments removes "//" and "/* */" comments and trailing commas, which are allowed in JSONC
+// but not in JSON. String literals are kept as is.
+func stripJSONComments(data []byte) []byte {
+	result := make([]byte, 0, len(data))
+
+	for i := 0; i < len(data); i++ {
+		switch c := data[i]; {
+		case c == '"':
+			end := skipJSONString(data, i)
+			result = append(result, data[i:end]...)
+			i = end - 1
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+
+			if i < len(data) {
+				result = append(result, '\n')
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			i += 2
+			for i+1 < len(data) && !(data[i] == '*' && data[i+1] == '/') {
+				i++
+			}
+			i++
+		case c == '}' || c == ']':
+			result = append(trimTrailingComma(result), c)
+		default:
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// skipJSONString returns index right after the string literal starting at start.
+func skipJSONString(data []byte, start int) int {
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+
+	return len(data)
+}
+
+func trimTrailingComma(data []byte) []byte {
+	i := len(data) - 1
+	for i >= 0 && isJSONWhitespace(data[i]) {
+		i--
+	}
+
+	if i >= 0 && data[i] == ',' {
+		return append(data[:i], data[i+1:]...)
+	}
+
+	return data
+}
+
+func isJSONWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
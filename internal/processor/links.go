@@ -0,0 +1,98 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/validation"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+	"github.com/trustwallet/assets/internal/file"
+)
+
+// maxRedirects is a number of redirects followed while checking links.
+const maxRedirects = 1
+
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > maxRedirects {
+				return http.ErrUseLastResponse
+			}
+
+			return nil
+		},
+	}
+}
+
+// ValidateAssetInfoLinks checks that website, explorer and social links of the asset respond with 2xx status.
+func (s *Service) ValidateAssetInfoLinks(ctx context.Context, f *file.AssetFile, timeout time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(f.Path(), &assetInfo); err != nil {
+		return err
+	}
+
+	compErr := validation.NewErrComposite()
+
+	for _, link := range assetInfoURLs(&assetInfo) {
+		if err := s.checkURL(ctx, link, timeout); err != nil {
+			compErr.Append(err)
+		}
+	}
+
+	if compErr.Len() > 0 {
+		return compErr
+	}
+
+	return nil
+}
+
+func assetInfoURLs(assetInfo *info.AssetModel) []string {
+	var urls []string
+
+	for _, u := range []*string{assetInfo.Website, assetInfo.Explorer, assetInfo.Twitter, assetInfo.CoinMarketcap} {
+		if u != nil && *u != "" {
+			urls = append(urls, *u)
+		}
+	}
+
+	for _, link := range assetInfo.Links {
+		if link.URL != nil && *link.URL != "" {
+			urls = append(urls, *link.URL)
+		}
+	}
+
+	return urls
+}
+
+func (s *Service) checkURL(ctx context.Context, url string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %s", validation.ErrInvalidField, url, err)
+	}
+
+	if err = s.CheckRateLimit(ctx); err != nil {
+		return err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %s is not reachable: %s", validation.ErrInvalidField, url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %s returned status %d", validation.ErrInvalidField, url, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+	"github.com/trustwallet/assets/internal/file"
+	"github.com/trustwallet/go-primitives/coin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	LintMissingField    = "missing-field"
+	LintInvalidType     = "invalid-type"
+	LintInvalidID       = "invalid-id"
+	LintInvalidExplorer = "invalid-explorer"
+	LintSymbolCase      = "symbol-case"
+	LintUntrimmedName   = "untrimmed-name"
+	LintInvalidStatus   = "invalid-status"
+	LintDuplicateTags   = "duplicate-tags"
+	LintUnsortedTags    = "unsorted-tags"
+)
+
+// LintIssue is a problem of an asset info field. Code is one of Lint* constants.
+type LintIssue struct {
+	Field   string
+	Code    string
+	Message string
+}
+
+// LintAssetInfo reports issues of the asset info file which FixAssetInfoJSON would correct, without modifying
+// the file. Symbol case is checked only for EVM chains with a configured rpc endpoint.
+func (s *Service) LintAssetInfo(ctx context.Context, f *file.AssetFile) ([]LintIssue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(f.Path(), &assetInfo); err != nil {
+		return nil, err
+	}
+
+	var issues []LintIssue
+
+	for _, field := range []struct {
+		name    string
+		missing bool
+	}{
+		{name: "name", missing: assetInfo.Name == nil || strings.TrimSpace(*assetInfo.Name) == ""},
+		{name: "symbol", missing: assetInfo.Symbol == nil || strings.TrimSpace(*assetInfo.Symbol) == ""},
+		{name: "type", missing: assetInfo.Type == nil || strings.TrimSpace(*assetInfo.Type) == ""},
+		{name: "decimals", missing: assetInfo.Decimals == nil},
+		{name: "description", missing: assetInfo.Description == nil || strings.TrimSpace(*assetInfo.Description) == ""},
+		{name: "website", missing: assetInfo.Website == nil},
+		{name: "explorer", missing: assetInfo.Explorer == nil || strings.TrimSpace(*assetInfo.Explorer) == ""},
+		{name: "status", missing: assetInfo.Status == nil || strings.TrimSpace(*assetInfo.Status) == ""},
+		{name: "id", missing: assetInfo.ID == nil || strings.TrimSpace(*assetInfo.ID) == ""},
+	} {
+		if field.missing {
+			issues = append(issues, LintIssue{Field: field.name, Code: LintMissingField,
+				Message: fmt.Sprintf("%s is missing", field.name)})
+		}
+	}
+
+	if assetInfo.Type != nil && *assetInfo.Type != "" {
+		if expected, ok := expectedAssetType(f, *assetInfo.Type); !ok {
+			issues = append(issues, LintIssue{Field: "type", Code: LintInvalidType,
+				Message: fmt.Sprintf("type should be '%s', given '%s'", expected, *assetInfo.Type)})
+		}
+	}
+
+	if assetInfo.ID != nil && *assetInfo.ID != "" && *assetInfo.ID != f.Asset() {
+		issues = append(issues, LintIssue{Field: "id", Code: LintInvalidID,
+			Message: fmt.Sprintf("id should be '%s' as the asset folder name, given '%s'", f.Asset(), *assetInfo.ID)})
+	}
+
+	if assetInfo.Explorer != nil && *assetInfo.Explorer != "" {
+		expectedExplorerURL, err := s.getExplorerURL(f.Chain(), f.Asset())
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.EqualFold(expectedExplorerURL, *assetInfo.Explorer) {
+			issues = append(issues, LintIssue{Field: "explorer", Code: LintInvalidExplorer,
+				Message: fmt.Sprintf("explorer should be '%s', given '%s'", expectedExplorerURL, *assetInfo.Explorer)})
+		}
+	}
+
+	if issue, ok := s.lintAssetSymbol(ctx, f, &assetInfo); ok {
+		issues = append(issues, issue)
+	}
+
+	if assetInfo.Name != nil && *assetInfo.Name != "" {
+		if name := normalizeWhitespace(*assetInfo.Name); name != *assetInfo.Name {
+			issues = append(issues, LintIssue{Field: "name", Code: LintUntrimmedName,
+				Message: fmt.Sprintf("name should be '%s', given '%s'", name, *assetInfo.Name)})
+		}
+	}
+
+	if assetInfo.Status != nil && *assetInfo.Status != "" && !isAllowedAssetStatus(*assetInfo.Status) {
+		issues = append(issues, LintIssue{Field: "status", Code: LintInvalidStatus,
+			Message: fmt.Sprintf("status '%s' is not allowed, use one of: %s",
+				*assetInfo.Status, strings.Join(allowedAssetStatuses, ", "))})
+	}
+
+	if tags := normalizeTags(assetInfo.Tags); len(tags) != len(assetInfo.Tags) {
+		issues = append(issues, LintIssue{Field: "tags", Code: LintDuplicateTags,
+			Message: fmt.Sprintf("tags contain %d duplicates", len(assetInfo.Tags)-len(tags))})
+	} else if !equalStrings(tags, assetInfo.Tags) {
+		issues = append(issues, LintIssue{Field: "tags", Code: LintUnsortedTags,
+			Message: fmt.Sprintf("tags should be lower-cased and sorted: %s", strings.Join(tags, ", "))})
+	}
+
+	return issues, nil
+}
+
+// lintAssetSymbol compares symbol case with the contract symbol, like fixAssetSymbol does.
+func (s *Service) lintAssetSymbol(
+	ctx context.Context, f *file.AssetFile, assetInfo *info.AssetModel,
+) (LintIssue, bool) {
+	if assetInfo.Symbol == nil || !coin.IsEVM(f.Chain().ID) || !s.hasRPCEndpoint(f.Chain()) {
+		return LintIssue{}, false
+	}
+
+	symbol, err := s.fetchTokenSymbol(ctx, f.Chain(), f.Asset())
+	if err != nil {
+		log.WithError(err).WithField("path", f.Path()).Debug("Failed to fetch token symbol")
+
+		return LintIssue{}, false
+	}
+
+	if symbol == *assetInfo.Symbol || !strings.EqualFold(symbol, *assetInfo.Symbol) {
+		return LintIssue{}, false
+	}
+
+	return LintIssue{Field: "symbol", Code: LintSymbolCase,
+		Message: fmt.Sprintf("symbol should be '%s' as in the contract, given '%s'", symbol, *assetInfo.Symbol)}, true
+}
+
+func isAllowedAssetStatus(status string) bool {
+	for _, allowed := range allowedAssetStatuses {
+		if status == allowed {
+			return true
+		}
+	}
+
+	return false
+}
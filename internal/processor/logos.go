@@ -0,0 +1,103 @@
+package processor
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/trustwallet/assets-go-libs/validation"
+	"github.com/trustwallet/assets/internal/file"
+)
+
+type FixProgress struct {
+	Done     int
+	Total    int
+	LastPath string
+	Err      error
+}
+
+// FixAllLogos runs FixLogo for all known logo files using a pool of workers and reports
+// progress after each file. Zero workers means one worker per CPU. The progress channel
+// may be nil, otherwise it has to be drained by the caller until the method returns.
+func (s *Service) FixAllLogos(ctx context.Context, workers int, progress chan<- FixProgress) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	var paths []string
+	for _, p := range s.fileService.GetPaths() {
+		if isLogoFile(s.fileService.GetAssetFile(p)) {
+			paths = append(paths, p)
+		}
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		done    int
+		compErr = validation.NewErrComposite()
+		sem     = make(chan struct{}, workers)
+	)
+
+	for _, p := range paths {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+
+		wg.Add(1)
+
+		go func(p string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			_, err := s.FixLogo(ctx, s.fileService.GetAssetFile(p))
+
+			mu.Lock()
+			done++
+			update := FixProgress{Done: done, Total: len(paths), LastPath: p, Err: err}
+			if err != nil {
+				compErr.Append(err)
+			}
+			mu.Unlock()
+
+			if progress == nil {
+				return
+			}
+
+			select {
+			case progress <- update:
+			case <-ctx.Done():
+			}
+		}(p)
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if compErr.Len() > 0 {
+		return compErr
+	}
+
+	return nil
+}
+
+func isLogoFile(f *file.AssetFile) bool {
+	switch f.Type() {
+	case file.TypeChainLogoFile, file.TypeAssetLogoFile, file.TypeValidatorsLogoFile, file.TypeDappsLogoFile:
+		return true
+	}
+
+	return false
+}
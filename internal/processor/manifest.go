@@ -0,0 +1,115 @@
+package processor
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const logoFileName = "logo.png"
+
+type (
+	ManifestEntry struct {
+		Path   string `json:"path"`
+		SHA256 string `json:"sha256"`
+	}
+
+	// ManifestMismatch describes a logo which differs from the manifest. Actual is empty for missing files.
+	ManifestMismatch struct {
+		Path     string
+		Expected string
+		Actual   string
+	}
+)
+
+// GenerateLogoManifest writes SHA-256 of every logo of the chain as JSON lines.
+func (s *Service) GenerateLogoManifest(ctx context.Context, chainHandle string, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+
+	return filepath.Walk(getChainPath(chainHandle), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if info.IsDir() || info.Name() != logoFileName {
+			return nil
+		}
+
+		sum, err := fileSHA256(path)
+		if err != nil {
+			return err
+		}
+
+		return encoder.Encode(ManifestEntry{Path: path, SHA256: sum})
+	})
+}
+
+// VerifyLogoManifest compares logos with the manifest written by GenerateLogoManifest.
+func (s *Service) VerifyLogoManifest(ctx context.Context, r io.Reader) ([]ManifestMismatch, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var mismatches []ManifestMismatch
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var entry ManifestEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest entry: %w", err)
+		}
+
+		sum, err := fileSHA256(entry.Path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		if sum != entry.SHA256 {
+			mismatches = append(mismatches, ManifestMismatch{Path: entry.Path, Expected: entry.SHA256, Actual: sum})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	return mismatches, nil
+}
+
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,162 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// MigrateChainAssets moves chain folder to the new handle and replaces the old handle in explorer urls
+// of asset info files and in token list logo urls. Modified files are copied to the backup dir first, by their
+// path relative to the chain folder. Empty backup dir means a new temp dir, its path is logged.
+func (s *Service) MigrateChainAssets(ctx context.Context, oldHandle, newHandle, backupDir string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	oldPath, newPath := getChainPath(oldHandle), getChainPath(newHandle)
+
+	if !fileLib.FileExists(oldPath) {
+		return fmt.Errorf("chain folder %s doesn't exist", oldPath)
+	}
+
+	if fileLib.FileExists(newPath) {
+		return fmt.Errorf("chain folder %s already exists", newPath)
+	}
+
+	if backupDir == "" {
+		var err error
+		if backupDir, err = os.MkdirTemp("", fmt.Sprintf("%s-migration-*", oldHandle)); err != nil {
+			return fmt.Errorf("failed to create backup dir: %w", err)
+		}
+	}
+
+	log.WithField("path", backupDir).Info("Migrated files are backed up")
+
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return fmt.Errorf("failed to rename chain folder: %w", err)
+	}
+
+	log.WithField("from", oldPath).WithField("to", newPath).Debug("Renamed chain folder")
+
+	handleRegexp := regexp.MustCompile(`\b` + regexp.QuoteMeta(oldHandle) + `\b`)
+
+	if err := s.migrateAssetExplorers(ctx, newHandle, handleRegexp, backupDir); err != nil {
+		return err
+	}
+
+	return s.migrateTokenList(oldHandle, newHandle, backupDir)
+}
+
+func (s *Service) migrateAssetExplorers(
+	ctx context.Context, chainHandle string, handleRegexp *regexp.Regexp, backupDir string,
+) error {
+	if !fileLib.FileExists(getChainAssetsPath(chainHandle)) {
+		return nil
+	}
+
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return err
+	}
+
+	for _, assetID := range assetIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		infoPath := path.GetAssetInfoPath(chainHandle, assetID)
+		if !fileLib.FileExists(infoPath) {
+			continue
+		}
+
+		var assetInfo info.AssetModel
+		if err = fileLib.ReadJSONFile(infoPath, &assetInfo); err != nil {
+			return err
+		}
+
+		if assetInfo.Explorer == nil || !handleRegexp.MatchString(*assetInfo.Explorer) {
+			continue
+		}
+
+		explorer := handleRegexp.ReplaceAllString(*assetInfo.Explorer, chainHandle)
+		assetInfo.Explorer = &explorer
+
+		if err = backupChainFile(chainHandle, infoPath, backupDir); err != nil {
+			return err
+		}
+
+		if err = s.createJSONFile(infoPath, &assetInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) migrateTokenList(oldHandle, newHandle, backupDir string) error {
+	tokenListPath := path.GetTokenListPath(newHandle)
+	if !fileLib.FileExists(tokenListPath) {
+		return nil
+	}
+
+	var tokenList TokenList
+	if err := fileLib.ReadJSONFile(tokenListPath, &tokenList); err != nil {
+		return err
+	}
+
+	oldPath, newPath := fmt.Sprintf("/%s/", getChainPath(oldHandle)), fmt.Sprintf("/%s/", getChainPath(newHandle))
+
+	var isModified bool
+	for i, token := range tokenList.Tokens {
+		if strings.Contains(token.LogoURI, oldPath) {
+			tokenList.Tokens[i].LogoURI = strings.ReplaceAll(token.LogoURI, oldPath, newPath)
+			isModified = true
+		}
+	}
+
+	if !isModified {
+		return nil
+	}
+
+	if err := backupChainFile(newHandle, tokenListPath, backupDir); err != nil {
+		return err
+	}
+
+	return s.createJSONFile(tokenListPath, &tokenList)
+}
+
+// backupChainFile copies the file of the chain to the backup dir, by its path relative to the chain folder.
+func backupChainFile(chainHandle, filePath, backupDir string) error {
+	chainPath := getChainPath(chainHandle)
+
+	relPath, err := filepath.Rel(chainPath, filePath)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	backupPath := filepath.Join(backupDir, relPath)
+	if err = fileLib.CreateDirPath(backupPath); err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(backupPath, data, fileModeReadWrite); err != nil {
+		return fmt.Errorf("failed to write backup file: %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package processor
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/trustwallet/assets/internal/file"
 	"github.com/trustwallet/go-primitives/types"
 )
@@ -8,17 +11,20 @@ import (
 type (
 	Validator struct {
 		Name string
-		Run  func(f *file.AssetFile) error
+		Run  func(ctx context.Context, f *file.AssetFile) error
+
+		// StopOnError skips the following validators of the file when Run fails, they rely on the check.
+		StopOnError bool
 	}
 
 	Fixer struct {
 		Name string
-		Run  func(f *file.AssetFile) error
+		Run  func(ctx context.Context, f *file.AssetFile) (*Result, error)
 	}
 
 	Updater struct {
 		Name string
-		Run  func() error
+		Run  func(ctx context.Context) error
 	}
 )
 
@@ -32,6 +38,7 @@ type (
 	}
 
 	TokenItem struct {
+		ChainID  int             `json:"chainId,omitempty"`
 		Asset    string          `json:"asset"`
 		Type     types.TokenType `json:"type"`
 		Address  string          `json:"address"`
@@ -55,6 +62,10 @@ type (
 	}
 )
 
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
 type (
 	ForceListPair struct {
 		Token0 string
@@ -0,0 +1,120 @@
+package processor
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type Option func(*Service)
+
+// WithRPCEndpoints sets EVM node URLs used for on-chain lookups, keyed by coin ID.
+func WithRPCEndpoints(endpoints map[uint]string) Option {
+	return func(s *Service) {
+		s.rpcEndpoints = endpoints
+	}
+}
+
+// WithDryRun makes fixers only log changes they would apply, without writing files.
+func WithDryRun(enabled bool) Option {
+	return func(s *Service) {
+		s.dryRun = enabled
+	}
+}
+
+// WithIndentStyle sets indentation of JSON files written by fixers and generators, e.g. IndentTab.
+func WithIndentStyle(style IndentStyle) Option {
+	return func(s *Service) {
+		s.indentStyle = style
+	}
+}
+
+// WithHTTPClient sets the client shared by link checks and rpc calls.
+func WithHTTPClient(client *http.Client) Option {
+	return func(s *Service) {
+		s.httpClient = client
+	}
+}
+
+// WithRateLimit limits requests to external APIs, e.g. link checks, rpc calls and CoinGecko lookups, to the
+// number of requests per second across all methods. Zero means no limit.
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(s *Service) {
+		if requestsPerSecond <= 0 {
+			s.rateLimiter = nil
+			return
+		}
+
+		s.rateLimiter = rate.NewLimiter(rate.Limit(requestsPerSecond), 1)
+	}
+}
+
+// WithGraphQLCacheTTL sets how long files read by GraphQLHandler resolvers are cached. Zero disables the cache.
+func WithGraphQLCacheTTL(ttl time.Duration) Option {
+	return func(s *Service) {
+		s.graphQLCacheTTL = ttl
+	}
+}
+
+// WithDescriptionDenylist sets boilerplate phrases which are not allowed in asset descriptions.
+func WithDescriptionDenylist(phrases []string) Option {
+	return func(s *Service) {
+		s.descriptionDenylist = phrases
+	}
+}
+
+// WithPhishingReferences sets names and symbols of top tokens, which assets are compared with to detect phishing.
+func WithPhishingReferences(references []string) Option {
+	return func(s *Service) {
+		s.phishingReferences = references
+	}
+}
+
+// WithPlaceholderLogoHashes sets hex-encoded SHA-256 hashes of known placeholder logos, which are not allowed.
+func WithPlaceholderLogoHashes(hashes []string) Option {
+	return func(s *Service) {
+		s.placeholderHashes = make(map[string]struct{}, len(hashes))
+		for _, hash := range hashes {
+			s.placeholderHashes[strings.ToLower(hash)] = struct{}{}
+		}
+	}
+}
+
+// WithExplorerTemplates sets explorer url templates of non-EVM chains keyed by chain handle,
+// e.g. "https://explorer.solana.com/address/{address}".
+func WithExplorerTemplates(templates map[string]string) Option {
+	return func(s *Service) {
+		s.explorerTemplates = templates
+	}
+}
+
+// WithExplorerPrefixes sets url prefixes of other explorers allowed in asset info files, keyed by chain handle.
+func WithExplorerPrefixes(prefixes map[string][]string) Option {
+	return func(s *Service) {
+		s.explorerPrefixes = prefixes
+	}
+}
+
+// WithLogoURLTemplate sets logo url template of token list entries,
+// e.g. "https://assets.trustwalletapp.com/blockchains/{chain}/assets/{asset}/logo.png".
+func WithLogoURLTemplate(template string) Option {
+	return func(s *Service) {
+		s.logoURLTemplate = template
+	}
+}
+
+// WithReadmeTemplate sets path to a text/template file used instead of the bundled chain README template.
+func WithReadmeTemplate(path string) Option {
+	return func(s *Service) {
+		s.readmeTemplatePath = path
+	}
+}
+
+// WithCoinGeckoAPIKey enables lookups of CoinGecko coins for asset info coingecko links.
+func WithCoinGeckoAPIKey(key string) Option {
+	return func(s *Service) {
+		s.coinGeckoAPIKey = key
+	}
+}
@@ -0,0 +1,170 @@
+package processor
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+)
+
+// defaultPhishingReferences are names and symbols of top tokens, which are mimicked by scam tokens.
+var defaultPhishingReferences = []string{
+	"Tether", "USDT",
+	"USD Coin", "USDC",
+	"Binance USD", "BUSD",
+	"Dai Stablecoin", "DAI",
+	"Wrapped Bitcoin", "WBTC",
+	"Wrapped Ether", "WETH",
+	"Chainlink", "LINK",
+	"Uniswap", "UNI",
+	"Shiba Inu", "SHIB",
+	"PancakeSwap", "CAKE",
+	"Trust Wallet", "TWT",
+}
+
+// PhishingCandidate is an asset with a name or a symbol similar to the one of a top token.
+type PhishingCandidate struct {
+	AssetPath  string
+	SimilarTo  string
+	Similarity float64
+}
+
+// DetectPhishingAssets compares names and symbols of assets of all chains with the reference top tokens and
+// returns assets with similarity above the threshold, from the most similar. Similarity is 1 minus Levenshtein
+// distance divided by the length of the longer value. Case-insensitive equal values are not reported, they are
+// the reference tokens themselves or their bridged copies.
+func (s *Service) DetectPhishingAssets(ctx context.Context, threshold float64) ([]PhishingCandidate, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	chains, err := getChainHandles()
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []PhishingCandidate
+
+	for _, chain := range chains {
+		if !fileLib.FileExists(getChainAssetsPath(chain)) {
+			continue
+		}
+
+		assetIDs, err := getChainAssetIDs(chain)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assetID := range assetIDs {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			infoPath := path.GetAssetInfoPath(chain, assetID)
+			if !fileLib.FileExists(infoPath) {
+				continue
+			}
+
+			var assetInfo info.AssetModel
+			if err = fileLib.ReadJSONFile(infoPath, &assetInfo); err != nil {
+				return nil, err
+			}
+
+			candidate, ok := s.mostSimilarReference(stringValue(assetInfo.Name), stringValue(assetInfo.Symbol))
+			if !ok || candidate.Similarity <= threshold {
+				continue
+			}
+
+			candidate.AssetPath = path.GetAssetPath(chain, assetID)
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Similarity > candidates[j].Similarity
+	})
+
+	return candidates, nil
+}
+
+func (s *Service) mostSimilarReference(values ...string) (PhishingCandidate, bool) {
+	var best PhishingCandidate
+	var found bool
+
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" {
+			continue
+		}
+
+		for _, reference := range s.phishingReferences {
+			lowerReference := strings.ToLower(reference)
+			if value == lowerReference {
+				continue
+			}
+
+			if similarity := stringSimilarity(value, lowerReference); !found || similarity > best.Similarity {
+				best = PhishingCandidate{SimilarTo: reference, Similarity: similarity}
+				found = true
+			}
+		}
+	}
+
+	return best, found
+}
+
+func stringSimilarity(a, b string) float64 {
+	maxLength := len([]rune(a))
+	if length := len([]rune(b)); length > maxLength {
+		maxLength = length
+	}
+
+	if maxLength == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshteinDistance(a, b))/float64(maxLength)
+}
+
+// levenshteinDistance returns the number of rune insertions, deletions and substitutions turning a into b.
+func levenshteinDistance(a, b string) int {
+	runesA, runesB := []rune(a), []rune(b)
+
+	previous := make([]int, len(runesB)+1)
+	current := make([]int, len(runesB)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(runesA); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(runesB); j++ {
+			substitution := previous[j-1]
+			if runesA[i-1] != runesB[j-1] {
+				substitution++
+			}
+
+			current[j] = minInt(substitution, previous[j]+1, current[j-1]+1)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(runesB)]
+}
+
+func minInt(first int, rest ...int) int {
+	result := first
+	for _, value := range rest {
+		if value < result {
+			result = value
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// CheckRateLimit waits for the rate limiter before a request to an external API. ErrRateLimitExceeded is
+// returned when the context deadline comes before the request is allowed. Without a rate limiter requests
+// are not limited.
+func (s *Service) CheckRateLimit(ctx context.Context) error {
+	if s.rateLimiter == nil {
+		return ctx.Err()
+	}
+
+	if err := s.rateLimiter.Wait(ctx); err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return ctx.Err()
+		}
+
+		return fmt.Errorf("%w: %s", ErrRateLimitExceeded, err)
+	}
+
+	return nil
+}
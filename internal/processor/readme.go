@@ -0,0 +1,120 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/template"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+)
+
+const defaultReadmeTemplate = `# {{ .Name }} ({{ .Symbol }})
+
+<img src="info/logo.png" width="64" height="64" alt="{{ .Name }} logo">
+
+{{ with .Description }}{{ . }}
+
+{{ end }}| | |
+|---|---|
+| Status | {{ .Status }} |
+{{- with .Website }}
+| Website | {{ . }} |
+{{- end }}
+{{- with .Explorer }}
+| Explorer | {{ . }} |
+{{- end }}
+| Assets | {{ .AssetCount }} |
+{{- if .HasTokenList }}
+| Token list | {{ .TokenListCount }} tokens |
+{{- end }}
+`
+
+// ChainReadme is the data passed to a chain README template.
+type ChainReadme struct {
+	Handle         string
+	Name           string
+	Symbol         string
+	Description    string
+	Status         string
+	Website        string
+	Explorer       string
+	AssetCount     int
+	HasTokenList   bool
+	TokenListCount int
+}
+
+// GenerateReadmeForChain renders a Markdown README of the chain from its info file and token list.
+func (s *Service) GenerateReadmeForChain(ctx context.Context, chainHandle string, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	tmpl, err := s.readmeTemplate()
+	if err != nil {
+		return err
+	}
+
+	var coinInfo info.CoinModel
+	if err = fileLib.ReadJSONFile(getChainInfoPath(chainHandle), &coinInfo); err != nil {
+		return err
+	}
+
+	readme := ChainReadme{
+		Handle:      chainHandle,
+		Name:        stringValue(coinInfo.Name),
+		Symbol:      stringValue(coinInfo.Symbol),
+		Description: stringValue(coinInfo.Description),
+		Status:      stringValue(coinInfo.Status),
+		Website:     stringValue(coinInfo.Website),
+		Explorer:    stringValue(coinInfo.Explorer),
+	}
+
+	if fileLib.FileExists(getChainAssetsPath(chainHandle)) {
+		assetIDs, err := getChainAssetIDs(chainHandle)
+		if err != nil {
+			return err
+		}
+
+		readme.AssetCount = len(assetIDs)
+	}
+
+	if fileLib.FileExists(path.GetTokenListPath(chainHandle)) {
+		chainFolder := s.fileService.GetAssetFile(fmt.Sprintf("./%s", getChainPath(chainHandle)))
+
+		if readme.TokenListCount, err = chainFolder.ChainTokenCount(); err != nil {
+			return err
+		}
+
+		readme.HasTokenList = true
+	}
+
+	if err = tmpl.Execute(w, readme); err != nil {
+		return fmt.Errorf("failed to render readme: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) readmeTemplate() (*template.Template, error) {
+	if s.readmeTemplatePath == "" {
+		return template.New("readme").Parse(defaultReadmeTemplate)
+	}
+
+	tmpl, err := template.ParseFiles(s.readmeTemplatePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse readme template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
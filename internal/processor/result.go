@@ -0,0 +1,68 @@
+package processor
+
+import (
+	"fmt"
+
+	"github.com/trustwallet/assets/internal/file"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ActionReformatted = "reformatted"
+	ActionRenamed     = "renamed"
+	ActionResized     = "resized"
+	ActionConverted   = "converted"
+	ActionCompressed  = "compressed"
+	ActionStripped    = "stripped"
+	ActionUpdated     = "updated"
+)
+
+// Result describes a change applied by a fixer. Before and After are set when a single value was changed.
+type Result struct {
+	Path   string
+	Action string
+	Before string
+	After  string
+}
+
+// FixError is returned by fixers, and keeps the action that failed.
+type FixError struct {
+	Result
+	Err error
+}
+
+func (e *FixError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Action, e.Err)
+}
+
+func (e *FixError) Unwrap() error {
+	return e.Err
+}
+
+func newFixError(f *file.AssetFile, action string, err error) *FixError {
+	return &FixError{
+		Result: Result{Path: f.Path(), Action: action},
+		Err:    err,
+	}
+}
+
+// applyFix runs write for the result, or only logs the result in dry-run mode.
+func (s *Service) applyFix(f *file.AssetFile, result *Result, write func() error) (*Result, error) {
+	if s.dryRun {
+		log.WithFields(log.Fields{
+			"path":   result.Path,
+			"action": result.Action,
+			"before": result.Before,
+			"after":  result.After,
+		}).Info("[dry-run] Fix is not applied")
+
+		return nil, nil
+	}
+
+	if err := write(); err != nil {
+		return nil, newFixError(f, result.Action, err)
+	}
+
+	return result, nil
+}
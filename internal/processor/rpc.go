@@ -0,0 +1,167 @@
+package processor
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+
+	"github.com/trustwallet/go-primitives/address"
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+const (
+	jsonRPCVersion = "2.0"
+	methodEthCall  = "eth_call"
+
+	// Function selectors of the ERC-20 token interface.
+	selectorDecimals = "0x313ce567"
+	selectorSymbol   = "0x95d89b41"
+
+	abiWordSize = 32
+)
+
+var errNoRPCEndpoint = errors.New("no rpc endpoint configured")
+
+type (
+	rpcRequest struct {
+		JSONRPC string        `json:"jsonrpc"`
+		ID      int           `json:"id"`
+		Method  string        `json:"method"`
+		Params  []interface{} `json:"params"`
+	}
+
+	rpcCallParams struct {
+		To   string `json:"to"`
+		Data string `json:"data"`
+	}
+
+	rpcResponse struct {
+		Result string    `json:"result"`
+		Error  *rpcError `json:"error"`
+	}
+
+	rpcError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+)
+
+func (s *Service) hasRPCEndpoint(chain coin.Coin) bool {
+	_, ok := s.rpcEndpoints[chain.ID]
+
+	return ok
+}
+
+func (s *Service) fetchTokenDecimals(ctx context.Context, chain coin.Coin, contract string) (int, error) {
+	result, err := s.callContract(ctx, chain, contract, selectorDecimals)
+	if err != nil {
+		return 0, err
+	}
+
+	value, ok := new(big.Int).SetString(address.Remove0x(result), 16)
+	if !ok || !value.IsUint64() || value.Uint64() > 255 {
+		return 0, fmt.Errorf("unexpected decimals value: %s", result)
+	}
+
+	return int(value.Uint64()), nil
+}
+
+func (s *Service) fetchTokenSymbol(ctx context.Context, chain coin.Coin, contract string) (string, error) {
+	result, err := s.callContract(ctx, chain, contract, selectorSymbol)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := hex.DecodeString(address.Remove0x(result))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode symbol: %w", err)
+	}
+
+	return decodeABIString(data)
+}
+
+// decodeABIString decodes ABI encoded string. Some old contracts return bytes32 instead, it's supported as well.
+func decodeABIString(data []byte) (string, error) {
+	if len(data) == abiWordSize {
+		return string(bytes.TrimRight(data, "\x00")), nil
+	}
+
+	if len(data) < 2*abiWordSize {
+		return "", fmt.Errorf("unexpected string value length: %d", len(data))
+	}
+
+	offset := new(big.Int).SetBytes(data[:abiWordSize])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(data)-abiWordSize) {
+		return "", errors.New("invalid string offset")
+	}
+
+	start := int(offset.Uint64()) + abiWordSize
+
+	length := new(big.Int).SetBytes(data[start-abiWordSize : start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(data)-start) {
+		return "", errors.New("invalid string length")
+	}
+
+	return string(data[start : start+int(length.Uint64())]), nil
+}
+
+// callContract executes eth_call against the latest block and returns the raw hex result.
+func (s *Service) callContract(ctx context.Context, chain coin.Coin, contract, data string) (string, error) {
+	endpoint, ok := s.rpcEndpoints[chain.ID]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", errNoRPCEndpoint, chain.Handle)
+	}
+
+	payload, err := json.Marshal(rpcRequest{
+		JSONRPC: jsonRPCVersion,
+		ID:      1,
+		Method:  methodEthCall,
+		Params:  []interface{}{rpcCallParams{To: contract, Data: data}, "latest"},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if err = s.CheckRateLimit(ctx); err != nil {
+		return "", err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to make POST request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read body: %w", err)
+	}
+
+	var result rpcResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal json: %w", err)
+	}
+
+	if result.Error != nil {
+		return "", fmt.Errorf("rpc error %d: %s", result.Error.Code, result.Error.Message)
+	}
+
+	if result.Result == "" || result.Result == "0x" {
+		return "", errors.New("empty eth_call result")
+	}
+
+	return result.Result, nil
+}
@@ -1,19 +1,76 @@
 package processor
 
 import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/trustwallet/assets/internal/config"
 	"github.com/trustwallet/assets/internal/file"
+	"golang.org/x/time/rate"
 )
 
 type Service struct {
 	fileService *file.Service
+
+	// renameMu guards folder renames, which also update the shared file cache.
+	renameMu *sync.Mutex
+
+	rpcEndpoints map[uint]string
+	httpClient   *http.Client
+
+	// rateLimiter is shared by requests to external APIs, nil means no limit.
+	rateLimiter *rate.Limiter
+
+	coinGeckoAPIKey string
+	coinGeckoCoins  *coinGeckoCoins
+
+	// tokenListSchema is compiled once, it is used by ValidateTokenListIntegrity for every token list.
+	tokenListSchema *jsonschema.Schema
+
+	descriptionDenylist []string
+	phishingReferences  []string
+	placeholderHashes   map[string]struct{}
+	explorerTemplates   map[string]string
+	explorerPrefixes    map[string][]string
+	logoURLTemplate     string
+	readmeTemplatePath  string
+	indentStyle         IndentStyle
+	graphQLCacheTTL     time.Duration
+
+	// logosWithoutAlpha counts logos without transparency, which are not reported one by one.
+	logosWithoutAlpha int32
+
+	dryRun bool
 }
 
-func NewService(fileProvider *file.Service) *Service {
-	return &Service{fileService: fileProvider}
+func NewService(fileProvider *file.Service, opts ...Option) *Service {
+	s := &Service{
+		fileService: fileProvider,
+		renameMu:    &sync.Mutex{},
+		httpClient:  newHTTPClient(),
+
+		coinGeckoCoins:  &coinGeckoCoins{},
+		tokenListSchema: mustCompileTokenListSchema(),
+
+		descriptionDenylist: defaultDescriptionDenylist,
+		phishingReferences:  defaultPhishingReferences,
+		indentStyle:         IndentFourSpaces,
+		graphQLCacheTTL:     defaultGraphQLCacheTTL,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 func (s *Service) GetValidator(f *file.AssetFile) []Validator {
 	jsonValidator := Validator{Name: "JSON validation", Run: s.ValidateJSON}
+	encodingValidator := Validator{Name: "Info files are UTF-8 without BOM", Run: s.ValidateInfoJSONEncoding}
 
 	switch f.Type() {
 	case file.TypeRootFolder:
@@ -26,11 +83,21 @@ func (s *Service) GetValidator(f *file.AssetFile) []Validator {
 		}
 	case file.TypeChainLogoFile, file.TypeAssetLogoFile, file.TypeValidatorsLogoFile, file.TypeDappsLogoFile:
 		return []Validator{
+			{Name: "Logos exist", Run: s.ValidateLogoFileExists, StopOnError: true},
+			// Signature is checked before other validators decode the image.
+			{Name: "Logos are PNG images", Run: s.ValidateLogoMIMEType, StopOnError: true},
 			{Name: "Logos (size, dimension)", Run: s.ValidateImage},
+			{Name: "Logos are square", Run: s.ValidateLogoAspectRatio},
+			{Name: "Logos are not smaller than minimum dimension", Run: s.ValidateLogoMinimumSize},
+			{Name: "Logos have transparency", Run: s.ValidateLogoTransparency},
+			{Name: "Logos are not placeholders", Run: s.ValidateLogoNotPlaceholder},
 		}
 	case file.TypeAssetFolder:
 		return []Validator{
 			{Name: "Each asset folder has valid asset address and contains logo/info", Run: s.ValidateAssetFolder},
+			{Name: "Cosmos asset folder has valid bech32 address", Run: s.ValidateCosmosAddress},
+			{Name: "Asset folder is named by address of the chain", Run: s.ValidateAssetDirName},
+			{Name: "Logos are named logo.png", Run: s.ValidateLogoFilename},
 		}
 	case file.TypeDappsFolder:
 		return []Validator{
@@ -38,12 +105,19 @@ func (s *Service) GetValidator(f *file.AssetFile) []Validator {
 		}
 	case file.TypeAssetInfoFile:
 		return []Validator{
+			encodingValidator,
 			jsonValidator,
 			{Name: "Asset info", Run: s.ValidateAssetInfoFile},
+			{Name: "Asset info has only known fields", Run: s.ValidateAssetInfoJSON},
+			{Name: "Asset info id matches asset folder", Run: s.ValidateAssetInfoID},
+			{Name: "Asset info status is allowed", Run: s.ValidateAssetInfoStatus},
+			{Name: "Asset info explorer matches chain explorer", Run: s.ValidateExplorerURL},
 		}
 	case file.TypeChainInfoFile:
 		return []Validator{
+			encodingValidator,
 			{Name: "Chain Info", Run: s.ValidateChainInfoFile},
+			{Name: "Chain Info has all required fields", Run: s.ValidateCoinModel},
 		}
 	case file.TypeValidatorsListFile:
 		return []Validator{
@@ -54,14 +128,22 @@ func (s *Service) GetValidator(f *file.AssetFile) []Validator {
 		return []Validator{
 			jsonValidator,
 			{Name: "Token list (if assets from list present in chain)", Run: s.ValidateTokenListFile},
+			{Name: "Token list items have all required fields", Run: s.ValidateTokenListSchema},
+			{Name: "Token list matches token list JSON schema", Run: s.ValidateTokenListIntegrity},
+			{Name: "Token list items have token type of the chain", Run: s.ValidateTokenItemTypes},
+			{Name: "Token list size is within chain limit", Run: func(ctx context.Context, f *file.AssetFile) error {
+				return s.ValidateTokenListSize(ctx, f, config.Default.ValidatorsSettings.TokenListFile.MaxTokens)
+			}},
 		}
 	case file.TypeChainInfoFolder:
 		return []Validator{
 			{Name: "Chain Info Folder (has files)", Run: s.ValidateInfoFolder},
+			{Name: "Logos are named logo.png", Run: s.ValidateLogoFilename},
 		}
 	case file.TypeValidatorsAssetFolder:
 		return []Validator{
 			{Name: "Validators asset folder (has logo, valid asset address)", Run: s.ValidateValidatorsAssetFolder},
+			{Name: "Logos are named logo.png", Run: s.ValidateLogoFilename},
 		}
 	}
 
@@ -89,9 +171,15 @@ func (s *Service) GetFixers(f *file.AssetFile) []Fixer {
 		return []Fixer{
 			jsonFixer,
 		}
+	case file.TypeTokenListFile:
+		return []Fixer{
+			jsonFixer,
+			{Name: "Fixing token list files", Run: s.FixTokenList},
+		}
 	case file.TypeAssetFolder:
 		return []Fixer{
 			{Name: "Renaming EVM's asset folder to valid address checksum", Run: s.FixETHAddressChecksum},
+			{Name: "Renaming Solana's asset folder to canonical base58 address", Run: s.FixSolanaAddress},
 		}
 	case file.TypeChainLogoFile, file.TypeAssetLogoFile, file.TypeValidatorsLogoFile, file.TypeDappsLogoFile:
 		return []Fixer{
@@ -0,0 +1,172 @@
+package processor
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+)
+
+const (
+	sitemapNamespace  = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	sitemapDateLayout = "2006-01-02"
+	sitemapIndent     = "  "
+
+	// sitemapMaxURLs is the limit of urls in a single sitemap file set by sitemaps.org.
+	sitemapMaxURLs = 50000
+
+	sitemapPartNameFormat = "sitemap-%d.xml"
+)
+
+type (
+	sitemapURLSet struct {
+		XMLName xml.Name     `xml:"urlset"`
+		XMLNS   string       `xml:"xmlns,attr"`
+		URLs    []sitemapURL `xml:"url"`
+	}
+
+	sitemapURL struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod,omitempty"`
+	}
+
+	sitemapIndex struct {
+		XMLName  xml.Name     `xml:"sitemapindex"`
+		XMLNS    string       `xml:"xmlns,attr"`
+		Sitemaps []sitemapURL `xml:"sitemap"`
+	}
+)
+
+// GenerateAssetSitemap writes a sitemap of active assets of all chains, with <baseURL>/<chain>/<address> urls and
+// modification dates of asset info files. When there are more urls than a sitemap allows, a sitemap index is
+// written instead, it refers to <baseURL>/sitemap-<part>.xml files written by GenerateAssetSitemapPart.
+func (s *Service) GenerateAssetSitemap(ctx context.Context, baseURL string, w io.Writer) error {
+	urls, err := collectSitemapURLs(ctx, baseURL)
+	if err != nil {
+		return err
+	}
+
+	if len(urls) <= sitemapMaxURLs {
+		return writeSitemapXML(w, sitemapURLSet{XMLNS: sitemapNamespace, URLs: urls})
+	}
+
+	index := sitemapIndex{XMLNS: sitemapNamespace}
+	for part := 1; part <= sitemapPartCount(len(urls)); part++ {
+		index.Sitemaps = append(index.Sitemaps, sitemapURL{
+			Loc: fmt.Sprintf("%s/"+sitemapPartNameFormat, strings.TrimRight(baseURL, "/"), part),
+		})
+	}
+
+	return writeSitemapXML(w, index)
+}
+
+// GenerateAssetSitemapPart writes the part of the asset sitemap referred by the sitemap index, parts start from 1.
+// It returns the total number of parts.
+func (s *Service) GenerateAssetSitemapPart(ctx context.Context, baseURL string, part int, w io.Writer) (int, error) {
+	urls, err := collectSitemapURLs(ctx, baseURL)
+	if err != nil {
+		return 0, err
+	}
+
+	parts := sitemapPartCount(len(urls))
+	if part < 1 || part > parts {
+		return parts, fmt.Errorf("sitemap part %d is out of range 1-%d", part, parts)
+	}
+
+	end := part * sitemapMaxURLs
+	if end > len(urls) {
+		end = len(urls)
+	}
+
+	urlSet := sitemapURLSet{XMLNS: sitemapNamespace, URLs: urls[(part-1)*sitemapMaxURLs : end]}
+
+	return parts, writeSitemapXML(w, urlSet)
+}
+
+// sitemapPartCount returns the number of sitemap files needed for the urls, a single sitemap has no parts.
+func sitemapPartCount(urls int) int {
+	if urls <= sitemapMaxURLs {
+		return 0
+	}
+
+	return (urls + sitemapMaxURLs - 1) / sitemapMaxURLs
+}
+
+func collectSitemapURLs(ctx context.Context, baseURL string) ([]sitemapURL, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	chains, err := getChainHandles()
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL = strings.TrimRight(baseURL, "/")
+
+	var urls []sitemapURL
+	for _, chain := range chains {
+		if !fileLib.FileExists(getChainAssetsPath(chain)) {
+			continue
+		}
+
+		assetIDs, err := getChainAssetIDs(chain)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assetID := range assetIDs {
+			if err = ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			infoPath := path.GetAssetInfoPath(chain, assetID)
+
+			stat, err := os.Stat(infoPath)
+			if os.IsNotExist(err) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+
+			var assetInfo info.AssetModel
+			if err = fileLib.ReadJSONFile(infoPath, &assetInfo); err != nil {
+				return nil, err
+			}
+
+			if assetInfo.GetStatus() != activeStatus {
+				continue
+			}
+
+			urls = append(urls, sitemapURL{
+				Loc:     fmt.Sprintf("%s/%s/%s", baseURL, chain, assetID),
+				LastMod: stat.ModTime().UTC().Format(sitemapDateLayout),
+			})
+		}
+	}
+
+	return urls, nil
+}
+
+func writeSitemapXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", sitemapIndent)
+
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode sitemap: %w", err)
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
@@ -0,0 +1,139 @@
+package processor
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+)
+
+var socialLinkNames = map[string]struct{}{
+	"twitter":       {},
+	"telegram":      {},
+	"telegram_news": {},
+	"discord":       {},
+	"reddit":        {},
+	"facebook":      {},
+	"medium":        {},
+	"youtube":       {},
+}
+
+// genericSocialURLs are social network home pages, which are not specific to a project.
+var genericSocialURLs = map[string]struct{}{
+	"https://twitter.com":      {},
+	"https://t.me":             {},
+	"https://discord.gg":       {},
+	"https://discord.com":      {},
+	"https://reddit.com":       {},
+	"https://www.reddit.com":   {},
+	"https://facebook.com":     {},
+	"https://www.facebook.com": {},
+	"https://medium.com":       {},
+	"https://youtube.com":      {},
+	"https://www.youtube.com":  {},
+}
+
+// SocialConflict is a social url used by more than one asset.
+type SocialConflict struct {
+	URL    string
+	Assets []string
+}
+
+// ValidateDuplicateSocialLinks finds social urls which are used by several assets across all chains.
+func (s *Service) ValidateDuplicateSocialLinks(ctx context.Context) ([]SocialConflict, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	chains, err := getChainHandles()
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string][]string)
+
+	for _, chain := range chains {
+		if !fileLib.FileExists(getChainAssetsPath(chain)) {
+			continue
+		}
+
+		assetIDs, err := getChainAssetIDs(chain)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assetID := range assetIDs {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			infoPath := path.GetAssetInfoPath(chain, assetID)
+			if !fileLib.FileExists(infoPath) {
+				continue
+			}
+
+			var assetInfo info.AssetModel
+			if err = fileLib.ReadJSONFile(infoPath, &assetInfo); err != nil {
+				return nil, err
+			}
+
+			for _, url := range socialURLs(&assetInfo) {
+				index[url] = append(index[url], path.GetAssetPath(chain, assetID))
+			}
+		}
+	}
+
+	var conflicts []SocialConflict
+	for url, assets := range index {
+		if len(assets) > 1 {
+			conflicts = append(conflicts, SocialConflict{URL: url, Assets: assets})
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].URL < conflicts[j].URL
+	})
+
+	return conflicts, nil
+}
+
+// socialURLs returns distinct normalized social urls of the asset, generic urls are skipped.
+func socialURLs(assetInfo *info.AssetModel) []string {
+	var candidates []string
+
+	if assetInfo.Twitter != nil {
+		candidates = append(candidates, *assetInfo.Twitter)
+	}
+
+	for _, link := range assetInfo.Links {
+		if link.Name == nil || link.URL == nil {
+			continue
+		}
+
+		if _, ok := socialLinkNames[*link.Name]; ok {
+			candidates = append(candidates, *link.URL)
+		}
+	}
+
+	seen := make(map[string]struct{}, len(candidates))
+	urls := make([]string, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		url := strings.TrimRight(strings.ToLower(strings.TrimSpace(candidate)), "/")
+		if _, ok := genericSocialURLs[url]; ok || url == "" {
+			continue
+		}
+
+		if _, ok := seen[url]; ok {
+			continue
+		}
+
+		seen[url] = struct{}{}
+		urls = append(urls, url)
+	}
+
+	return urls
+}
@@ -0,0 +1,200 @@
+package processor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+	"github.com/trustwallet/assets/internal/file"
+)
+
+// Points of asset score items, they sum up to 100.
+const (
+	scoreLogo        = 20
+	scoreLogoSize    = 10
+	scoreBasics      = 15
+	scoreDescription = 10
+	scoreWebsite     = 10
+	scoreExplorer    = 10
+	scoreStatus      = 10
+	scoreCoinGecko   = 15
+
+	scoreDescriptionMinLength = 100
+)
+
+type ChainStatistics struct {
+	Chain            string `json:"chain"`
+	TotalTokens      int    `json:"totalTokens"`
+	ActiveTokens     int    `json:"activeTokens"`
+	MissingLogoCount int    `json:"missingLogoCount"`
+	MissingInfoCount int    `json:"missingInfoCount"`
+	AverageLogoBytes int64  `json:"averageLogoBytes"`
+	HasTokenList     bool   `json:"hasTokenList"`
+}
+
+// ChainStats collects statistics of the chain assets.
+func (s *Service) ChainStats(ctx context.Context, chainHandle string) (*ChainStatistics, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	stats := &ChainStatistics{
+		Chain:        chainHandle,
+		HasTokenList: fileLib.FileExists(path.GetTokenListPath(chainHandle)),
+	}
+
+	if !fileLib.FileExists(getChainAssetsPath(chainHandle)) {
+		return stats, nil
+	}
+
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	var logoCount, logoBytes int64
+
+	for _, assetID := range assetIDs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		stats.TotalTokens++
+
+		logo, err := os.Stat(path.GetAssetLogoPath(chainHandle, assetID))
+		if err == nil {
+			logoCount++
+			logoBytes += logo.Size()
+		} else {
+			stats.MissingLogoCount++
+		}
+
+		infoPath := path.GetAssetInfoPath(chainHandle, assetID)
+		if !fileLib.FileExists(infoPath) {
+			stats.MissingInfoCount++
+			continue
+		}
+
+		var assetInfo info.AssetModel
+		if err = fileLib.ReadJSONFile(infoPath, &assetInfo); err != nil {
+			return nil, err
+		}
+
+		if assetInfo.GetStatus() == activeStatus {
+			stats.ActiveTokens++
+		}
+	}
+
+	if logoCount > 0 {
+		stats.AverageLogoBytes = logoBytes / logoCount
+	}
+
+	return stats, nil
+}
+
+// CountAssetsByStatus counts assets of the chain grouped by status of their info files. Only the status key is
+// decoded, assets without info file are skipped and assets without status are counted under the empty status.
+func (s *Service) CountAssetsByStatus(ctx context.Context, chainHandle string) (map[string]int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+
+	if !fileLib.FileExists(getChainAssetsPath(chainHandle)) {
+		return counts, nil
+	}
+
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assetID := range assetIDs {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		data, err := os.ReadFile(path.GetAssetInfoPath(chainHandle, assetID))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		var assetStatus struct {
+			Status string `json:"status"`
+		}
+
+		if err = json.Unmarshal(bytes.TrimPrefix(data, utf8BOM), &assetStatus); err != nil {
+			return nil, fmt.Errorf("%s: %w", path.GetAssetInfoPath(chainHandle, assetID), err)
+		}
+
+		counts[assetStatus.Status]++
+	}
+
+	return counts, nil
+}
+
+// AssetScore is completeness of asset metadata. Total is out of 100, Breakdown has points of each score item.
+type AssetScore struct {
+	Total     int            `json:"total"`
+	Breakdown map[string]int `json:"breakdown"`
+}
+
+// ComputeAssetScore scores completeness of the asset metadata, asset info file is required.
+func (s *Service) ComputeAssetScore(ctx context.Context, f *file.AssetFile) (AssetScore, error) {
+	if err := ctx.Err(); err != nil {
+		return AssetScore{}, err
+	}
+
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(path.GetAssetInfoPath(f.Chain().Handle, f.Asset()), &assetInfo); err != nil {
+		return AssetScore{}, err
+	}
+
+	logoPath := path.GetAssetLogoPath(f.Chain().Handle, f.Asset())
+	hasLogo := fileLib.FileExists(logoPath)
+
+	items := []struct {
+		name   string
+		points int
+		ok     bool
+	}{
+		{name: "logo", points: scoreLogo, ok: hasLogo},
+		{name: "logoSize", points: scoreLogoSize, ok: hasLogo && validation.ValidateLogoFileSize(logoPath) == nil},
+		{name: "basics", points: scoreBasics, ok: isNotEmpty(assetInfo.Name) && isNotEmpty(assetInfo.Symbol) &&
+			assetInfo.Decimals != nil},
+		{name: "description", points: scoreDescription, ok: assetInfo.Description != nil &&
+			utf8.RuneCountInString(strings.TrimSpace(*assetInfo.Description)) >= scoreDescriptionMinLength},
+		{name: "website", points: scoreWebsite, ok: isNotEmpty(assetInfo.Website)},
+		{name: "explorer", points: scoreExplorer, ok: isNotEmpty(assetInfo.Explorer)},
+		{name: "status", points: scoreStatus, ok: assetInfo.GetStatus() == activeStatus},
+		{name: "coingecko", points: scoreCoinGecko, ok: hasLink(&assetInfo, linkNameCoinGecko)},
+	}
+
+	score := AssetScore{Breakdown: make(map[string]int, len(items))}
+	for _, item := range items {
+		if !item.ok {
+			score.Breakdown[item.name] = 0
+			continue
+		}
+
+		score.Breakdown[item.name] = item.points
+		score.Total += item.points
+	}
+
+	return score, nil
+}
+
+func isNotEmpty(value *string) bool {
+	return value != nil && strings.TrimSpace(*value) != ""
+}
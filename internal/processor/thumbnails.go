@@ -0,0 +1,67 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"image/png"
+	"io"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets/internal/image"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const thumbnailSize = 32
+
+// RenderLogoThumbnail writes a 32x32 PNG preview of the logo.
+func (s *Service) RenderLogoThumbnail(ctx context.Context, logoPath string, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	thumbnail, err := image.Thumbnail(logoPath, thumbnailSize)
+	if err != nil {
+		return err
+	}
+
+	if err = png.Encode(w, thumbnail); err != nil {
+		return fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	return nil
+}
+
+// RenderChainThumbnailSheet writes a PNG image with thumbnails of all chain asset logos tiled into a square grid,
+// in the order of asset folder names. Logos that can't be decoded are skipped.
+func (s *Service) RenderChainThumbnailSheet(ctx context.Context, chainHandle string, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return err
+	}
+
+	logoPaths := make([]string, 0, len(assetIDs))
+	for _, assetID := range assetIDs {
+		if logoPath := path.GetAssetLogoPath(chainHandle, assetID); fileLib.FileExists(logoPath) {
+			logoPaths = append(logoPaths, logoPath)
+		}
+	}
+
+	sheet := image.ThumbnailSheet(logoPaths, thumbnailSize, func(logoPath string, err error) {
+		log.WithError(err).WithField("path", logoPath).Warn("Logo is skipped in thumbnail sheet")
+	})
+	if sheet == nil {
+		return fmt.Errorf("no logos found for chain %s", chainHandle)
+	}
+
+	if err = png.Encode(w, sheet); err != nil {
+		return fmt.Errorf("failed to encode thumbnail sheet: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,382 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	fileLib "github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+	"github.com/trustwallet/assets/internal/config"
+	"github.com/trustwallet/assets/internal/file"
+	assetlib "github.com/trustwallet/go-primitives/asset"
+	"github.com/trustwallet/go-primitives/coin"
+	"github.com/trustwallet/go-primitives/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// GenerateChainTokenList writes token list of the chain built from info files of its active assets. Asset folders
+// without a readable info file are skipped. Version of the existing token list, if any is readable, is incremented.
+func (s *Service) GenerateChainTokenList(ctx context.Context, chainHandle string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	chain, err := coin.GetCoinForId(chainHandle)
+	if err != nil {
+		return err
+	}
+
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return err
+	}
+
+	tokens := make([]TokenItem, 0, len(assetIDs))
+	for _, assetID := range assetIDs {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		assetInfoPath := path.GetAssetInfoPath(chainHandle, assetID)
+		if !fileLib.FileExists(assetInfoPath) {
+			log.WithField("path", path.GetAssetPath(chainHandle, assetID)).Warn("Skipped asset folder without info file")
+			continue
+		}
+
+		var assetInfo info.AssetModel
+		if err = fileLib.ReadJSONFile(assetInfoPath, &assetInfo); err != nil {
+			log.WithError(err).WithField("path", assetInfoPath).Warn("Skipped unreadable asset info file")
+			continue
+		}
+
+		if assetInfo.GetStatus() != activeStatus {
+			continue
+		}
+
+		tokens = append(tokens, newTokenItem(chain, assetID, &assetInfo))
+	}
+
+	return s.writeChainTokenList(chain, tokens)
+}
+
+// RepairTokenListFromAssetDirs rebuilds the token list of the chain from info files of its active assets, e.g.
+// when tokenlist.json is deleted or corrupted. Asset folders without a readable info file are skipped.
+// The existing token list is not read, version of the rebuilt list is 1.0.0.
+func (s *Service) RepairTokenListFromAssetDirs(ctx context.Context, chainHandle string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	chain, err := coin.GetCoinForId(chainHandle)
+	if err != nil {
+		return err
+	}
+
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return err
+	}
+
+	tokens := make([]TokenItem, 0, len(assetIDs))
+	for _, assetID := range assetIDs {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		assetInfoPath := path.GetAssetInfoPath(chainHandle, assetID)
+		if !fileLib.FileExists(assetInfoPath) {
+			continue
+		}
+
+		var assetInfo info.AssetModel
+		if err = fileLib.ReadJSONFile(assetInfoPath, &assetInfo); err != nil {
+			log.WithError(err).WithField("path", assetInfoPath).Warn("Skipped unreadable asset info file")
+			continue
+		}
+
+		if assetInfo.GetStatus() != activeStatus {
+			continue
+		}
+
+		tokens = append(tokens, newTokenItem(chain, assetID, &assetInfo))
+	}
+
+	if err = s.writeTokenList(chain, tokens, Version{Major: 1}); err != nil {
+		return err
+	}
+
+	log.WithField("chain", chainHandle).WithField("tokens", len(tokens)).Info("Repaired token list from asset folders")
+
+	return nil
+}
+
+// writeChainTokenList writes sorted tokens to the token list of the chain. Version of the existing token list,
+// if any is readable, is incremented.
+func (s *Service) writeChainTokenList(chain coin.Coin, tokens []TokenItem) error {
+	var version Version
+	var oldTokenList TokenList
+	if err := fileLib.ReadJSONFile(path.GetTokenListPath(chain.Handle), &oldTokenList); err == nil {
+		version = oldTokenList.Version
+	}
+
+	return s.writeTokenList(chain, tokens, Version{Major: version.Major + 1})
+}
+
+// writeTokenList writes sorted tokens to the token list of the chain with the given version.
+func (s *Service) writeTokenList(chain coin.Coin, tokens []TokenItem, version Version) error {
+	sortTokens(tokens)
+
+	tokenListPath := path.GetTokenListPath(chain.Handle)
+
+	log.Debugf("Tokenlist: generated list with %d tokens written to %s.", len(tokens), tokenListPath)
+
+	return s.createJSONFile(tokenListPath, &TokenList{
+		Name:      fmt.Sprintf("Trust Wallet: %s", chain.Name),
+		LogoURI:   twLogoURL,
+		Timestamp: time.Now().Format(timestampFormat),
+		Tokens:    tokens,
+		Version:   version,
+	})
+}
+
+func newTokenItem(chain coin.Coin, assetID string, assetInfo *info.AssetModel) TokenItem {
+	token := TokenItem{
+		Asset:   assetlib.BuildID(chain.ID, assetID),
+		Address: assetID,
+		LogoURI: path.GetAssetLogoURL(config.Default.URLs.TWAssetsApp, chain.Handle, assetID),
+		Pairs:   make([]Pair, 0),
+	}
+
+	if assetInfo.Type != nil {
+		token.Type = types.TokenType(*assetInfo.Type)
+	}
+
+	if assetInfo.Name != nil {
+		token.Name = *assetInfo.Name
+	}
+
+	if assetInfo.Symbol != nil {
+		token.Symbol = *assetInfo.Symbol
+	}
+
+	if assetInfo.Decimals != nil {
+		token.Decimals = uint(*assetInfo.Decimals)
+	}
+
+	return token
+}
+
+// MergeTokenLists writes tokens of both token lists, deduplicated by address and sorted by sortTokens. Entries of a
+// are preferred over entries of b with the same address, addresses are compared case-insensitively. Name and logo
+// of the merged list are taken from a, minor version is bumped from the higher version of both lists.
+func (s *Service) MergeTokenLists(ctx context.Context, a, b io.Reader, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var listA, listB TokenList
+	if err := json.NewDecoder(a).Decode(&listA); err != nil {
+		return fmt.Errorf("failed to decode first token list: %w", err)
+	}
+
+	if err := json.NewDecoder(b).Decode(&listB); err != nil {
+		return fmt.Errorf("failed to decode second token list: %w", err)
+	}
+
+	seen := make(map[string]TokenItem, len(listA.Tokens)+len(listB.Tokens))
+	tokens := make([]TokenItem, 0, len(listA.Tokens)+len(listB.Tokens))
+
+	for _, token := range append(listA.Tokens, listB.Tokens...) {
+		key := strings.ToLower(token.Address)
+		if kept, ok := seen[key]; ok {
+			if !reflect.DeepEqual(kept, token) {
+				log.WithField("address", token.Address).
+					WithField("kept", kept.Symbol).
+					WithField("dropped", token.Symbol).
+					Warn("Token lists have different entries for the address")
+			}
+
+			continue
+		}
+
+		seen[key] = token
+		tokens = append(tokens, token)
+	}
+
+	sortTokens(tokens)
+
+	version := listA.Version
+	if compareVersions(listB.Version, version) > 0 {
+		version = listB.Version
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", string(s.indentStyle))
+
+	return encoder.Encode(&TokenList{
+		Name:      listA.Name,
+		LogoURI:   listA.LogoURI,
+		Timestamp: time.Now().Format(timestampFormat),
+		Tokens:    tokens,
+		Version:   Version{Major: version.Major, Minor: version.Minor + 1},
+	})
+}
+
+// Version bumps required by EIP-747 for token list changes, from the least significant.
+const (
+	versionBumpNone = iota
+	versionBumpPatch
+	versionBumpMinor
+	versionBumpMajor
+)
+
+// ValidateTokenListVersion compares the token list with the baseline one, e.g. from the main branch, and checks
+// that the version is bumped as EIP-747 requires: major for removed tokens, minor for added tokens and patch
+// for changed tokens.
+func (s *Service) ValidateTokenListVersion(ctx context.Context, f *file.AssetFile, baseline io.Reader) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var tokenList, baselineTokenList TokenList
+	if err := fileLib.ReadJSONFile(f.Path(), &tokenList); err != nil {
+		return err
+	}
+
+	if err := json.NewDecoder(baseline).Decode(&baselineTokenList); err != nil {
+		return fmt.Errorf("failed to decode baseline token list: %w", err)
+	}
+
+	old, current := baselineTokenList.Version, tokenList.Version
+	if compareVersions(current, old) < 0 {
+		return fmt.Errorf("%w: version %s is lower than baseline %s", validation.ErrInvalidField,
+			current, old)
+	}
+
+	var bumped bool
+	switch bump := requiredVersionBump(baselineTokenList.Tokens, tokenList.Tokens); bump {
+	case versionBumpMajor:
+		bumped = current.Major > old.Major
+	case versionBumpMinor:
+		bumped = current.Major > old.Major || current.Major == old.Major && current.Minor > old.Minor
+	case versionBumpPatch:
+		bumped = compareVersions(current, old) > 0
+	default:
+		return nil
+	}
+
+	if !bumped {
+		return fmt.Errorf("%w: tokens are changed, version %s is not bumped enough from %s",
+			validation.ErrInvalidField, current, old)
+	}
+
+	return nil
+}
+
+// CompareChainTokenCounts returns the difference between numbers of tokens of the chain token list and
+// the baseline token list, e.g. from the main branch. ErrSignificantDrop is returned when the token list lost
+// a larger share of the baseline tokens than the configured percentage.
+func (s *Service) CompareChainTokenCounts(
+	ctx context.Context, chainHandle string, baselineJSON io.Reader,
+) (delta int, err error) {
+	if err = ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	var baseline TokenList
+	if err = json.NewDecoder(baselineJSON).Decode(&baseline); err != nil {
+		return 0, fmt.Errorf("failed to decode baseline token list: %w", err)
+	}
+
+	chainFolder := s.fileService.GetAssetFile(fmt.Sprintf("./%s", getChainPath(chainHandle)))
+
+	count, err := chainFolder.ChainTokenCount()
+	if err != nil {
+		return 0, err
+	}
+
+	delta = count - len(baseline.Tokens)
+
+	maxDropPercent := config.Default.ValidatorsSettings.TokenListFile.MaxDropPercent
+	if maxDropPercent == 0 {
+		maxDropPercent = defaultMaxTokenDropPercent
+	}
+
+	if float64(-delta) > float64(len(baseline.Tokens))*maxDropPercent/100 {
+		return delta, fmt.Errorf("%w: %d of %d tokens removed, more than %.0f%%",
+			ErrSignificantDrop, -delta, len(baseline.Tokens), maxDropPercent)
+	}
+
+	return delta, nil
+}
+
+// ValidateTokenListTimestamp checks that the token list timestamp is RFC3339. Timestamps older than maxAge are
+// only reported as warnings.
+func (s *Service) ValidateTokenListTimestamp(ctx context.Context, f *file.AssetFile, maxAge time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var tokenList TokenList
+	if err := fileLib.ReadJSONFile(f.Path(), &tokenList); err != nil {
+		return err
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, tokenList.Timestamp)
+	if err != nil {
+		return fmt.Errorf("%w: timestamp '%s' is not RFC3339", validation.ErrInvalidField, tokenList.Timestamp)
+	}
+
+	if age := time.Since(timestamp); age > maxAge {
+		log.WithField("path", f.Path()).
+			WithField("timestamp", tokenList.Timestamp).
+			Warnf("Token list is not updated for %d days", int(age.Hours()/24))
+	}
+
+	return nil
+}
+
+// requiredVersionBump returns the most significant bump required by differences of the token lists.
+func requiredVersionBump(oldTokens, newTokens []TokenItem) int {
+	oldByAsset := make(map[string]TokenItem, len(oldTokens))
+	for _, token := range oldTokens {
+		oldByAsset[token.Asset] = token
+	}
+
+	bump := versionBumpNone
+	for _, token := range newTokens {
+		oldToken, ok := oldByAsset[token.Asset]
+		switch {
+		case !ok:
+			bump = versionBumpMinor
+		case bump == versionBumpNone && !reflect.DeepEqual(oldToken, token):
+			bump = versionBumpPatch
+		}
+
+		delete(oldByAsset, token.Asset)
+	}
+
+	if len(oldByAsset) > 0 {
+		return versionBumpMajor
+	}
+
+	return bump
+}
+
+func compareVersions(a, b Version) int {
+	for _, diff := range []int{a.Major - b.Major, a.Minor - b.Minor, a.Patch - b.Patch} {
+		if diff != 0 {
+			return diff
+		}
+	}
+
+	return 0
+}
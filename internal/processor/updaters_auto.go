@@ -1,10 +1,12 @@
 package processor
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -28,11 +30,17 @@ const (
 	marketPairsLimit = 1000
 	tokensListLimit  = 10000
 
-	twLogoURL       = "https://trustwallet.com/assets/images/favicon.png"
-	timestampFormat = "2006-01-02T15:04:05.000000"
+	twLogoURL = "https://trustwallet.com/assets/images/favicon.png"
+
+	// timestampFormat is RFC3339 with microseconds, as ValidateTokenListTimestamp expects.
+	timestampFormat = "2006-01-02T15:04:05.000000Z07:00"
 )
 
-func (s *Service) UpdateBinanceTokens() error {
+func (s *Service) UpdateBinanceTokens(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	explorerClient := explorer.InitClient(config.Default.ClientURLs.Binance.Explorer, nil)
 
 	bep2AssetList, err := explorerClient.FetchBep2Assets(assetsPage, assetsRows)
@@ -57,7 +65,7 @@ func (s *Service) UpdateBinanceTokens() error {
 		return err
 	}
 
-	err = fetchMissingAssets(chain, bep2AssetList.AssetInfoList)
+	err = s.fetchMissingAssets(chain, bep2AssetList.AssetInfoList)
 	if err != nil {
 		return err
 	}
@@ -67,10 +75,10 @@ func (s *Service) UpdateBinanceTokens() error {
 		return err
 	}
 
-	return createTokenListJSON(chain, tokens)
+	return s.createTokenListJSON(chain, tokens)
 }
 
-func fetchMissingAssets(chain coin.Coin, assets []explorer.Bep2Asset) error {
+func (s *Service) fetchMissingAssets(chain coin.Coin, assets []explorer.Bep2Asset) error {
 	for _, a := range assets {
 		if a.AssetImg == "" || a.Decimals == 0 {
 			continue
@@ -85,7 +93,7 @@ func fetchMissingAssets(chain coin.Coin, assets []explorer.Bep2Asset) error {
 			return err
 		}
 
-		if err := createInfoJSON(chain, a); err != nil {
+		if err := s.createInfoJSON(chain, a); err != nil {
 			return err
 		}
 	}
@@ -102,7 +110,7 @@ func createLogo(assetLogoPath string, a explorer.Bep2Asset) error {
 	return image.CreatePNGFromURL(a.AssetImg, assetLogoPath)
 }
 
-func createInfoJSON(chain coin.Coin, a explorer.Bep2Asset) error {
+func (s *Service) createInfoJSON(chain coin.Coin, a explorer.Bep2Asset) error {
 	explorerURL, err := coin.GetCoinExploreURL(chain, a.Asset)
 	if err != nil {
 		return err
@@ -127,10 +135,10 @@ func createInfoJSON(chain coin.Coin, a explorer.Bep2Asset) error {
 
 	assetInfoPath := path.GetAssetInfoPath(chain.Handle, a.Asset)
 
-	return fileLib.CreateJSONFile(assetInfoPath, &assetInfo)
+	return s.createJSONFile(assetInfoPath, &assetInfo)
 }
 
-func createTokenListJSON(chain coin.Coin, tokens []TokenItem) error {
+func (s *Service) createTokenListJSON(chain coin.Coin, tokens []TokenItem) error {
 	tokenListPath := path.GetTokenListPath(chain.Handle)
 
 	var oldTokenList TokenList
@@ -152,7 +160,7 @@ func createTokenListJSON(chain coin.Coin, tokens []TokenItem) error {
 	log.Debugf("Tokenlist: list with %d tokens and %d pairs written to %s.",
 		len(tokens), countTotalPairs(tokens), tokenListPath)
 
-	return fileLib.CreateJSONFile(tokenListPath, &TokenList{
+	return s.createJSONFile(tokenListPath, &TokenList{
 		Name:      fmt.Sprintf("Trust Wallet: %s", coin.Coins[chain.ID].Name),
 		LogoURI:   twLogoURL,
 		Timestamp: time.Now().Format(timestampFormat),
@@ -170,20 +178,38 @@ func countTotalPairs(tokens []TokenItem) int {
 	return counter
 }
 
-func sortTokens(tokens []TokenItem) {
-	sort.Slice(tokens, func(i, j int) bool {
-		if len(tokens[i].Pairs) != len(tokens[j].Pairs) {
-			return len(tokens[i].Pairs) > len(tokens[j].Pairs)
+// sortTokens sorts tokens by symbol, case-insensitive, then by address, and pairs of each token by base.
+// It is the order of all written token lists. Reports whether the order has changed.
+func sortTokens(tokens []TokenItem) bool {
+	less := func(i, j int) bool {
+		symbolI, symbolJ := strings.ToLower(tokens[i].Symbol), strings.ToLower(tokens[j].Symbol)
+		if symbolI != symbolJ {
+			return symbolI < symbolJ
 		}
 
 		return tokens[i].Address < tokens[j].Address
-	})
+	}
+
+	var isModified bool
+
+	if !sort.SliceIsSorted(tokens, less) {
+		sort.Slice(tokens, less)
+		isModified = true
+	}
 
 	for _, token := range tokens {
-		sort.Slice(token.Pairs, func(i, j int) bool {
-			return token.Pairs[i].Base < token.Pairs[j].Base
-		})
+		pairs := token.Pairs
+		lessPair := func(i, j int) bool {
+			return pairs[i].Base < pairs[j].Base
+		}
+
+		if !sort.SliceIsSorted(pairs, lessPair) {
+			sort.Slice(pairs, lessPair)
+			isModified = true
+		}
 	}
+
+	return isModified
 }
 
 func generateTokenList(marketPairs []binance.MarketPair, tokenList binance.Tokens) ([]TokenItem, error) {
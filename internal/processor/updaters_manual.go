@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -55,7 +56,11 @@ var (
 )
 
 // nolint:dupl
-func (s *Service) UpdateEthereumTokenlist() error {
+func (s *Service) UpdateEthereumTokenlist(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	log.WithFields(log.Fields{
 		"limit_liquidity": config.Default.TradingPairSettings.Uniswap.MinLiquidity,
 		"volume":          config.Default.TradingPairSettings.Uniswap.MinVol24,
@@ -95,11 +100,15 @@ func (s *Service) UpdateEthereumTokenlist() error {
 		pairs = append(pairs, []TokenItem{*tokenItem0, *tokenItem1})
 	}
 
-	return rebuildTokenList(chain, pairs, forceExclude)
+	return s.rebuildTokenList(chain, pairs, forceExclude)
 }
 
 // nolint:dupl
-func (s *Service) UpdateSmartchainTokenlist() error {
+func (s *Service) UpdateSmartchainTokenlist(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	log.WithFields(log.Fields{
 		"limit_liquidity": config.Default.TradingPairSettings.Pancakeswap.MinLiquidity,
 		"volume":          config.Default.TradingPairSettings.Pancakeswap.MinVol24,
@@ -140,7 +149,7 @@ func (s *Service) UpdateSmartchainTokenlist() error {
 		pairs = append(pairs, []TokenItem{*tokenItem0, *tokenItem1})
 	}
 
-	return rebuildTokenList(chain, pairs, forceExclude)
+	return s.rebuildTokenList(chain, pairs, forceExclude)
 }
 
 func retrievePairs(url string, query map[string]string, minLiquidity, minVol24, minTxCount24 int,
@@ -376,7 +385,7 @@ func matchPairToForceList(token0, token1 *TokenItem, forceList []ForceListPair)
 	return matched
 }
 
-func rebuildTokenList(chain coin.Coin, pairs [][]TokenItem, forceExcludeList []string) error {
+func (s *Service) rebuildTokenList(chain coin.Coin, pairs [][]TokenItem, forceExcludeList []string) error {
 	if pairs == nil || len(pairs) < 5 {
 		return nil
 	}
@@ -429,7 +438,7 @@ func rebuildTokenList(chain coin.Coin, pairs [][]TokenItem, forceExcludeList []s
 
 	log.Debugf("Tokenlist updated: %d tokens", len(list.Tokens))
 
-	return createTokenListJSON(chain, list.Tokens)
+	return s.createTokenListJSON(chain, list.Tokens)
 }
 
 func checkTokenExists(chain, tokenID string) bool {
@@ -2,22 +2,77 @@ package processor
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net/url"
 	"os"
-
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	fileLib "github.com/trustwallet/assets-go-libs/file"
 	"github.com/trustwallet/assets-go-libs/path"
 	"github.com/trustwallet/assets-go-libs/validation"
 	"github.com/trustwallet/assets-go-libs/validation/info"
 	"github.com/trustwallet/assets-go-libs/validation/list"
 	"github.com/trustwallet/assets/internal/config"
 	"github.com/trustwallet/assets/internal/file"
+	"github.com/trustwallet/assets/internal/image"
 	"github.com/trustwallet/go-primitives/coin"
 	"github.com/trustwallet/go-primitives/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var utf8BOM = []byte("\xEF\xBB\xBF")
+
+// cosmosAddressPrefixes are bech32 human-readable parts of account and contract addresses of Cosmos chains.
+var cosmosAddressPrefixes = map[uint]string{
+	coin.COSMOS:    "cosmos",
+	coin.KAVA:      "kava",
+	coin.TERRA:     "terra",
+	coin.BAND:      "band",
+	coin.THORCHAIN: "thor",
+	coin.OSMOSIS:   "osmo",
+}
+
+var (
+	evmAssetDirName    = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+	base58AssetDirName = regexp.MustCompile(`^[1-9A-HJ-NP-Za-km-z]{32,44}$`)
+
+	// assetDirNames are formats of asset folder names of non-EVM chains, other chains are not checked.
+	assetDirNames = map[uint]*regexp.Regexp{
+		coin.SOLANA: base58AssetDirName,
+		coin.WAVES:  base58AssetDirName,
+		// TRC20 contract address or TRC10 token ID.
+		coin.TRON: regexp.MustCompile(`^(T[1-9A-HJ-NP-Za-km-z]{33}|[0-9]+)$`),
+		// BEP2 symbol with a 3 hex digits suffix, BEP8 mini tokens end with "M".
+		coin.BINANCE: regexp.MustCompile(`^[A-Z0-9.]+-[0-9A-F]{3}M?$`),
+	}
+
+	// Asset folders of Cosmos chains are bech32 contract addresses or native denominations, e.g. "uusd".
+	cosmosAssetDirName = regexp.MustCompile(`^[a-z]+1[02-9ac-hj-np-z]{38,58}$`)
+	cosmosDenomination = regexp.MustCompile(`^[a-z][a-z0-9]{1,15}$`)
 )
 
-func (s *Service) ValidateJSON(f *file.AssetFile) error {
+func (s *Service) ValidateJSON(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	file, err := os.Open(f.Path())
 	if err != nil {
 		return err
@@ -38,7 +93,33 @@ func (s *Service) ValidateJSON(f *file.AssetFile) error {
 	return nil
 }
 
-func (s *Service) ValidateRootFolder(f *file.AssetFile) error {
+// ValidateInfoJSONEncoding rejects info files with a UTF-8 byte order mark or with bytes which are not UTF-8.
+func (s *Service) ValidateInfoJSONEncoding(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(f.Path())
+	if err != nil {
+		return err
+	}
+
+	if bytes.HasPrefix(data, utf8BOM) {
+		return ErrUTF8BOM
+	}
+
+	if !utf8.Valid(data) {
+		return ErrInvalidUTF8
+	}
+
+	return nil
+}
+
+func (s *Service) ValidateRootFolder(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	file, err := os.Open(f.Path())
 	if err != nil {
 		return err
@@ -58,7 +139,11 @@ func (s *Service) ValidateRootFolder(f *file.AssetFile) error {
 	return nil
 }
 
-func (s *Service) ValidateChainFolder(f *file.AssetFile) error {
+func (s *Service) ValidateChainFolder(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	file, err := os.Open(f.Path())
 	if err != nil {
 		return err
@@ -94,7 +179,11 @@ func (s *Service) ValidateChainFolder(f *file.AssetFile) error {
 	return nil
 }
 
-func (s *Service) ValidateImage(f *file.AssetFile) error {
+func (s *Service) ValidateImage(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	var compErr = validation.NewErrComposite()
 
 	err := validation.ValidateLogoFileSize(f.Path())
@@ -116,7 +205,315 @@ func (s *Service) ValidateImage(f *file.AssetFile) error {
 	return nil
 }
 
-func (s *Service) ValidateAssetFolder(f *file.AssetFile) error {
+// ValidateLogoMIMEType rejects logo.png files with content of another format, e.g. JPEG or GIF.
+func (s *Service) ValidateLogoMIMEType(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return image.CheckPNGSignature(f.Path())
+}
+
+// ValidateLogoFileExists checks that the logo file is present, e.g. it was not removed after the file structure
+// was read, so the following logo validators don't fail with raw file system errors.
+func (s *Service) ValidateLogoFileExists(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	_, err := os.Stat(f.Path())
+	if err == nil {
+		return nil
+	}
+
+	if !os.IsNotExist(err) {
+		return err
+	}
+
+	if f.Asset() != "" {
+		return fmt.Errorf("%w: logo.png missing for asset %s on chain %s",
+			validation.ErrMissingFile, f.Asset(), f.Chain().Handle)
+	}
+
+	return fmt.Errorf("%w: %s missing", validation.ErrMissingFile, f.Path())
+}
+
+// ValidateLogoFilename checks that files of the folder named logo.png in any case are named exactly logo.png.
+// Other casings are the same file on case-insensitive file systems, but not in the repository, and such files
+// are not taken as logos.
+func (s *Service) ValidateLogoFilename(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	dirFiles, err := os.ReadDir(f.Path())
+	if err != nil {
+		return err
+	}
+
+	for _, dirFile := range dirFiles {
+		if name := dirFile.Name(); name != logoFileName && strings.EqualFold(name, logoFileName) {
+			return fmt.Errorf("%w: logo file name should be %s, given %s",
+				validation.ErrInvalidFileNameCase, logoFileName, name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateLogoMinimumSize rejects logos which are too small to be displayed sharply.
+func (s *Service) ValidateLogoMinimumSize(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	width, height, _, err := image.GetImageDimensions(f.Path())
+	if err != nil {
+		return err
+	}
+
+	if width < logoMinW || height < logoMinH {
+		return fmt.Errorf("%w: min - %dx%d, given %dx%d",
+			validation.ErrInvalidImgDimension, logoMinW, logoMinH, width, height)
+	}
+
+	return nil
+}
+
+// ValidateLogoAspectRatio rejects logos which are not square.
+func (s *Service) ValidateLogoAspectRatio(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	width, height, _, err := image.GetImageDimensions(f.Path())
+	if err != nil {
+		return err
+	}
+
+	diff := width - height
+	if diff < 0 {
+		diff = -diff
+	}
+
+	if diff > logoAspectRatioTolerance {
+		return fmt.Errorf("%w: logo should be square, given %dx%d", validation.ErrInvalidImgDimension, width, height)
+	}
+
+	return nil
+}
+
+// ValidateLogoSquareness rejects logos whose longer side exceeds the shorter one by more than tolerancePct,
+// e.g. 0.05 allows 5%. Unlike ValidateLogoAspectRatio the tolerance is relative, so off-by-one sizes left by
+// resizing pass for small and large logos alike.
+func (s *Service) ValidateLogoSquareness(ctx context.Context, f *file.AssetFile, tolerancePct float64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	width, height, _, err := image.GetImageDimensions(f.Path())
+	if err != nil {
+		return err
+	}
+
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("%w: given %dx%d", validation.ErrInvalidImgDimension, width, height)
+	}
+
+	longer, shorter := math.Max(float64(width), float64(height)), math.Min(float64(width), float64(height))
+	if ratio := longer/shorter - 1; ratio > tolerancePct {
+		return fmt.Errorf("%w: logo should be square within %.0f%%, given %dx%d",
+			validation.ErrInvalidImgDimension, tolerancePct*100, width, height)
+	}
+
+	return nil
+}
+
+// ValidateAssetDirName checks that the asset folder name has the address format of the chain, e.g. a hex address
+// on EVM chains, so copies like "0xABCDEF_backup" are rejected.
+func (s *Service) ValidateAssetDirName(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	name := filepath.Base(f.Path())
+	chain := f.Chain()
+
+	var valid bool
+	switch prefix, isCosmos := cosmosAddressPrefixes[chain.ID]; {
+	case coin.IsEVM(chain.ID):
+		valid = evmAssetDirName.MatchString(name)
+	case isCosmos:
+		valid = cosmosDenomination.MatchString(name) ||
+			strings.HasPrefix(name, prefix+"1") && cosmosAssetDirName.MatchString(name)
+	default:
+		format, ok := assetDirNames[chain.ID]
+		valid = !ok || format.MatchString(name)
+	}
+
+	if !valid {
+		return fmt.Errorf("%w: asset folder name '%s' does not match %s address format",
+			validation.ErrInvalidAddress, name, chain.Handle)
+	}
+
+	return nil
+}
+
+// ValidateCosmosAddress checks that asset folders of Cosmos chains named as bech32 address have the chain prefix
+// and a valid checksum. Native denominations, e.g. "uusd", are not addresses and are skipped.
+func (s *Service) ValidateCosmosAddress(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	prefix, ok := cosmosAddressPrefixes[f.Chain().ID]
+	if !ok {
+		return nil
+	}
+
+	assetID := f.Asset()
+
+	hrp, data, err := bech32.Decode(assetID)
+	if err != nil {
+		if strings.HasPrefix(strings.ToLower(assetID), prefix+"1") {
+			return fmt.Errorf("%w: %s", validation.ErrInvalidAddress, err)
+		}
+
+		return nil
+	}
+
+	if hrp != prefix {
+		return fmt.Errorf("%w: address prefix should be %s, given %s", validation.ErrInvalidAddress, prefix, hrp)
+	}
+
+	if _, err = bech32.ConvertBits(data, 5, 8, false); err != nil {
+		return fmt.Errorf("%w: %s", validation.ErrInvalidAddress, err)
+	}
+
+	return nil
+}
+
+// ValidateLogoTransparency checks that PNG logo has transparent background support, so it looks right
+// in dark and light themes. Missing transparency is only counted, see LogosWithoutAlpha, unless it is required
+// in config.
+func (s *Service) ValidateLogoTransparency(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	hasTransparency, err := image.HasTransparency(f.Path())
+	if errors.Is(err, image.ErrUnsupportedFormat) || hasTransparency {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !config.Default.ValidatorsSettings.LogoFile.RequireTransparency {
+		atomic.AddInt32(&s.logosWithoutAlpha, 1)
+		log.WithField("path", f.Path()).Trace("Logo has no alpha channel")
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: logo should have an alpha channel", image.ErrNoTransparency)
+}
+
+// LogosWithoutAlpha returns the number of logos without transparency passed by ValidateLogoTransparency.
+func (s *Service) LogosWithoutAlpha() int {
+	return int(atomic.LoadInt32(&s.logosWithoutAlpha))
+}
+
+// ValidateLogoPixelDensity checks resolution declared by pHYs chunk of PNG logo is logoDPI. Logos without the chunk
+// pass, not all tools write it. The validator is not added to the CI chain yet, existing logos declare 72 DPI and
+// other resolutions.
+func (s *Service) ValidateLogoPixelDensity(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	dpiX, dpiY, ok, err := image.GetPixelDensity(f.Path())
+	if err != nil || !ok {
+		return err
+	}
+
+	// Pixels per meter are integers, e.g. 96 DPI is stored as 3780 and read back as 96.01.
+	if int(math.Round(dpiX)) != logoDPI || int(math.Round(dpiY)) != logoDPI {
+		return fmt.Errorf("%w: logo declares %.0fx%.0f DPI, expected %d DPI",
+			image.ErrInvalidPixelDensity, dpiX, dpiY, logoDPI)
+	}
+
+	return nil
+}
+
+// ValidateLogoNotPlaceholder rejects logos matching known placeholder hashes and blank logos, i.e. PNG logos with
+// variance of pixel luminance below the configured minimum. Other formats are reported by ValidateImage. Spam and
+// abandoned assets don't require a logo, so their logos are not checked for blankness, and logos the PNG decoder
+// rejects are skipped, as ValidateImage only reads their header.
+func (s *Service) ValidateLogoNotPlaceholder(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(f.Path())
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(data)
+	if _, ok := s.placeholderHashes[hex.EncodeToString(hash[:])]; ok {
+		return fmt.Errorf("%w: known placeholder hash", image.ErrPlaceholderLogo)
+	}
+
+	if image.DetectFormat(data) != image.FormatPNG {
+		return nil
+	}
+
+	if f.Type() == file.TypeAssetLogoFile {
+		if status := assetStatus(f.Chain().Handle, f.Asset()); status == "spam" || status == "abandoned" {
+			return nil
+		}
+	}
+
+	variance, err := image.LuminanceVariance(f.Path())
+	if err != nil {
+		log.WithError(err).WithField("path", f.Path()).Debug("Skipped placeholder check of undecodable logo")
+		return nil
+	}
+
+	minVariance := config.Default.ValidatorsSettings.LogoFile.MinLuminanceVariance
+	if minVariance == 0 {
+		minVariance = defaultMinLuminanceVariance
+	}
+
+	if variance < minVariance {
+		return fmt.Errorf("%w: luminance variance %.2f is below %.2f", image.ErrPlaceholderLogo, variance, minVariance)
+	}
+
+	return nil
+}
+
+// ValidateImageColorspace rejects PNG logos tagged with a colorspace other than sRGB.
+// Other formats are reported by ValidateImage.
+func (s *Service) ValidateImageColorspace(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err := image.CheckSRGB(f.Path())
+	if err != nil && !errors.Is(err, image.ErrUnsupportedFormat) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ValidateAssetFolder(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	file, err := os.Open(f.Path())
 	if err != nil {
 		return err
@@ -178,7 +575,11 @@ func (s *Service) ValidateAssetFolder(f *file.AssetFile) error {
 	return nil
 }
 
-func (s *Service) ValidateDappsFolder(f *file.AssetFile) error {
+func (s *Service) ValidateDappsFolder(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	file, err := os.Open(f.Path())
 	if err != nil {
 		return err
@@ -210,7 +611,11 @@ func (s *Service) ValidateDappsFolder(f *file.AssetFile) error {
 	return nil
 }
 
-func (s *Service) ValidateChainInfoFile(f *file.AssetFile) error {
+func (s *Service) ValidateChainInfoFile(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	file, err := os.Open(f.Path())
 	if err != nil {
 		return err
@@ -247,74 +652,560 @@ func (s *Service) ValidateChainInfoFile(f *file.AssetFile) error {
 	return nil
 }
 
-func (s *Service) ValidateAssetInfoFile(f *file.AssetFile) error {
-	file, err := os.Open(f.Path())
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	buf := bytes.NewBuffer(nil)
-	if _, err = buf.ReadFrom(file); err != nil {
+// ValidateAssetInfoJSON rejects asset info files with fields which are not part of the asset model. Such fields
+// are dropped by the asset info fixer, they are reported separately so CI doesn't depend on the fixer.
+func (s *Service) ValidateAssetInfoJSON(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
 		return err
 	}
 
-	_, err = file.Seek(0, io.SeekStart)
+	data, err := os.ReadFile(f.Path())
 	if err != nil {
-		return fmt.Errorf("%w: failed to seek reader", validation.ErrInvalidJson)
+		return err
 	}
 
-	var payload info.AssetModel
-	err = json.Unmarshal(buf.Bytes(), &payload)
-	if err != nil {
-		return fmt.Errorf("%w: failed to decode", err)
-	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
 
-	err = info.ValidateAsset(payload, f.Chain(), f.Asset())
-	if err != nil {
-		return err
+	var assetInfo info.AssetModel
+	if err = decoder.Decode(&assetInfo); err != nil {
+		return fmt.Errorf("%w: %s", validation.ErrInvalidJson, err)
 	}
 
 	return nil
 }
 
-func (s *Service) ValidateValidatorsListFile(f *file.AssetFile) error {
-	file, err := os.Open(f.Path())
-	if err != nil {
+// ValidateAssetInfoStatus checks that asset status is one of allowedAssetStatuses.
+func (s *Service) ValidateAssetInfoStatus(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
 		return err
 	}
-	defer file.Close()
 
-	if !isStackingChain(f.Chain()) {
-		return nil
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(f.Path(), &assetInfo); err != nil {
+		return err
 	}
 
-	buf := bytes.NewBuffer(nil)
-	if _, err = buf.ReadFrom(file); err != nil {
-		return err
+	if assetInfo.Status == nil {
+		return fmt.Errorf("%w: status", validation.ErrMissingField)
 	}
 
-	var model []list.Model
-	err = json.Unmarshal(buf.Bytes(), &model)
-	if err != nil {
+	if isAllowedAssetStatus(*assetInfo.Status) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: status '%s' is not allowed, use one of: %s",
+		validation.ErrInvalidField, *assetInfo.Status, strings.Join(allowedAssetStatuses, ", "))
+}
+
+// ValidateAssetInfoName checks that asset name has from assetNameMinLength to assetNameMaxLength characters,
+// all of them printable, without leading or trailing whitespace. Each violated rule is reported.
+func (s *Service) ValidateAssetInfoName(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
 		return err
 	}
 
-	err = list.ValidateList(model)
-	if err != nil {
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(f.Path(), &assetInfo); err != nil {
 		return err
 	}
 
-	listIDs := make([]string, len(model))
-	for i, listItem := range model {
-		listIDs[i] = *listItem.ID
+	if assetInfo.Name == nil {
+		return fmt.Errorf("%w: name", validation.ErrMissingField)
 	}
 
-	assetsPath := path.GetValidatorAssetsPath(f.Chain().Handle)
-	assetFolder := s.fileService.GetAssetFile(assetsPath)
+	name := *assetInfo.Name
+	compErr := validation.NewErrComposite()
 
-	file2, err := os.Open(assetFolder.Path())
-	if err != nil {
+	if length := utf8.RuneCountInString(name); length < assetNameMinLength {
+		compErr.Append(fmt.Errorf("%w: '%s' has %d characters, min %d", ErrNameTooShort, name, length, assetNameMinLength))
+	} else if length > assetNameMaxLength {
+		compErr.Append(fmt.Errorf("%w: '%s' has %d characters, max %d", ErrNameTooLong, name, length, assetNameMaxLength))
+	}
+
+	for _, r := range name {
+		if !unicode.IsPrint(r) {
+			compErr.Append(fmt.Errorf("%w: %q", ErrNameNotPrintable, name))
+			break
+		}
+	}
+
+	if strings.TrimSpace(name) != name {
+		compErr.Append(fmt.Errorf("%w: '%s'", ErrNameUntrimmed, name))
+	}
+
+	if compErr.Len() > 0 {
+		return compErr
+	}
+
+	return nil
+}
+
+// ValidateAssetInfoSymbol checks that asset symbol has from assetSymbolMinLength to assetSymbolMaxLength
+// characters, which are letters, digits and hyphens, at least one of them a letter. Each violated rule is reported.
+func (s *Service) ValidateAssetInfoSymbol(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(f.Path(), &assetInfo); err != nil {
+		return err
+	}
+
+	if assetInfo.Symbol == nil {
+		return fmt.Errorf("%w: symbol", validation.ErrMissingField)
+	}
+
+	symbol := *assetInfo.Symbol
+	compErr := validation.NewErrComposite()
+
+	if length := utf8.RuneCountInString(symbol); length < assetSymbolMinLength || length > assetSymbolMaxLength {
+		compErr.Append(fmt.Errorf("%w: '%s' has %d characters, expected %d-%d",
+			ErrSymbolLength, symbol, length, assetSymbolMinLength, assetSymbolMaxLength))
+	}
+
+	var hasLetter, hasInvalid bool
+	for _, r := range symbol {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r), r == '-':
+		default:
+			hasInvalid = true
+		}
+	}
+
+	if hasInvalid {
+		compErr.Append(fmt.Errorf("%w: %q", ErrSymbolCharacters, symbol))
+	}
+
+	if !hasLetter && symbol != "" {
+		compErr.Append(fmt.Errorf("%w: '%s'", ErrSymbolNoLetter, symbol))
+	}
+
+	if compErr.Len() > 0 {
+		return compErr
+	}
+
+	return nil
+}
+
+// ValidateExplorerURL checks that asset explorer url points to the explorer of the asset chain: its host matches
+// the expected explorer url, or it starts with one of the prefixes allowed for the chain.
+func (s *Service) ValidateExplorerURL(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(f.Path(), &assetInfo); err != nil {
+		return err
+	}
+
+	// Missing explorer is reported by asset info validation.
+	if assetInfo.Explorer == nil {
+		return nil
+	}
+
+	explorer := *assetInfo.Explorer
+
+	if s.hasAllowedExplorerPrefix(f.Chain(), explorer) {
+		return nil
+	}
+
+	expectedExplorer, err := s.getExplorerURL(f.Chain(), f.Asset())
+	if err != nil {
+		return err
+	}
+
+	expectedURL, err := url.Parse(expectedExplorer)
+	if err != nil {
+		return err
+	}
+
+	explorerURL, err := url.Parse(explorer)
+	if err != nil {
+		return fmt.Errorf("%w: explorer: %s", validation.ErrInvalidField, err)
+	}
+
+	if !strings.EqualFold(explorerURL.Hostname(), expectedURL.Hostname()) {
+		return fmt.Errorf("%w: explorer host should be %s for %s, given %s", validation.ErrInvalidField,
+			expectedURL.Hostname(), f.Chain().Handle, explorerURL.Hostname())
+	}
+
+	return nil
+}
+
+// hasAllowedExplorerPrefix reports whether the explorer url starts with one of explorer prefixes of the chain.
+func (s *Service) hasAllowedExplorerPrefix(chain coin.Coin, explorer string) bool {
+	for _, prefix := range s.explorerPrefixes[chain.Handle] {
+		if strings.HasPrefix(strings.ToLower(explorer), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateAssetInfoID checks that asset id in the info file matches the asset folder name.
+func (s *Service) ValidateAssetInfoID(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(f.Path(), &assetInfo); err != nil {
+		return err
+	}
+
+	if assetInfo.ID == nil {
+		return fmt.Errorf("%w: id", validation.ErrMissingField)
+	}
+
+	if *assetInfo.ID != f.Asset() {
+		return fmt.Errorf("%w: id should be '%s' as the asset folder name, given '%s'",
+			validation.ErrInvalidField, f.Asset(), *assetInfo.ID)
+	}
+
+	return nil
+}
+
+// ValidateAssetInfoDescription checks that asset description is meaningful: not too short and not a boilerplate.
+func (s *Service) ValidateAssetInfoDescription(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var assetInfo info.AssetModel
+	if err := fileLib.ReadJSONFile(f.Path(), &assetInfo); err != nil {
+		return err
+	}
+
+	if assetInfo.Description == nil || strings.TrimSpace(*assetInfo.Description) == "" {
+		return fmt.Errorf("%w: description", validation.ErrMissingField)
+	}
+
+	description := strings.TrimSpace(*assetInfo.Description)
+	if length := utf8.RuneCountInString(description); length < descriptionMinLength {
+		return fmt.Errorf("%w: description should be at least %d characters, given %d",
+			validation.ErrInvalidField, descriptionMinLength, length)
+	}
+
+	lowerDescription := strings.ToLower(description)
+	for _, phrase := range s.descriptionDenylist {
+		if strings.Contains(lowerDescription, strings.ToLower(phrase)) {
+			return fmt.Errorf("%w: description contains boilerplate phrase '%s'", validation.ErrInvalidField, phrase)
+		}
+	}
+
+	return nil
+}
+
+// ValidateCoinModel checks that chain info file has all required fields and lists the missing ones.
+func (s *Service) ValidateCoinModel(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var coinInfo info.CoinModel
+	if err := fileLib.ReadJSONFile(f.Path(), &coinInfo); err != nil {
+		return err
+	}
+
+	var missing []string
+
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{name: "name", value: coinInfo.Name},
+		{name: "symbol", value: coinInfo.Symbol},
+		{name: "status", value: coinInfo.Status},
+		{name: "type", value: coinInfo.Type},
+		{name: "explorer", value: coinInfo.Explorer},
+		{name: "website", value: coinInfo.Website},
+	} {
+		if field.value == nil || strings.TrimSpace(*field.value) == "" {
+			missing = append(missing, field.name)
+		}
+	}
+
+	if coinInfo.Decimals == nil {
+		missing = append(missing, "decimals")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", validation.ErrMissingField, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// ValidateChainConsistency checks that chain info file describes the coin registered for the chain folder handle.
+// Chain info has no coin ID, so its symbol and decimals are compared with the registered coin.
+func (s *Service) ValidateChainConsistency(ctx context.Context, chainHandle string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	chain, err := coin.GetCoinForId(chainHandle)
+	if err != nil {
+		return fmt.Errorf("%w: chain folder %s is not a known coin handle", validation.ErrNotAllowedFile, chainHandle)
+	}
+
+	var coinInfo info.CoinModel
+	if err = fileLib.ReadJSONFile(getChainInfoPath(chainHandle), &coinInfo); err != nil {
+		return err
+	}
+
+	compErr := validation.NewErrComposite()
+
+	if coinInfo.Symbol != nil && !strings.EqualFold(*coinInfo.Symbol, chain.Symbol) {
+		compErr.Append(fmt.Errorf("%w: symbol should be %s for %s, given %s",
+			validation.ErrInvalidField, chain.Symbol, chainHandle, *coinInfo.Symbol))
+	}
+
+	if coinInfo.Decimals != nil && uint(*coinInfo.Decimals) != chain.Decimals {
+		compErr.Append(fmt.Errorf("%w: decimals should be %d for %s, given %d",
+			validation.ErrInvalidField, chain.Decimals, chainHandle, *coinInfo.Decimals))
+	}
+
+	if compErr.Len() > 0 {
+		return compErr
+	}
+
+	return nil
+}
+
+// ValidateNoOrphanedAssetDirectories returns paths of the chain asset folders without info.json,
+// including empty folders and folders with a logo only.
+func (s *Service) ValidateNoOrphanedAssetDirectories(ctx context.Context, chainHandle string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !fileLib.FileExists(getChainAssetsPath(chainHandle)) {
+		return nil, nil
+	}
+
+	assetIDs, err := getChainAssetIDs(chainHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphaned []string
+	for _, assetID := range assetIDs {
+		if !fileLib.FileExists(path.GetAssetInfoPath(chainHandle, assetID)) {
+			orphaned = append(orphaned, path.GetAssetPath(chainHandle, assetID))
+		}
+	}
+
+	return orphaned, nil
+}
+
+// FindAssetsWithoutLogo returns paths of asset folders without logo.png. Empty chain handle means all chains.
+func (s *Service) FindAssetsWithoutLogo(ctx context.Context, chainHandle string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	chainHandles := []string{chainHandle}
+	if chainHandle == "" {
+		var err error
+		if chainHandles, err = getChainHandles(); err != nil {
+			return nil, err
+		}
+	}
+
+	var result []string
+	for _, handle := range chainHandles {
+		if !fileLib.FileExists(getChainAssetsPath(handle)) {
+			continue
+		}
+
+		assetIDs, err := getChainAssetIDs(handle)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assetID := range assetIDs {
+			if err = ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			if !fileLib.FileExists(path.GetAssetLogoPath(handle, assetID)) {
+				result = append(result, path.GetAssetPath(handle, assetID))
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// ValidateNoSymlinkAssets returns paths of symbolic links under the root, including broken links, and
+// ErrSymlink when there are any. filepath.Walk describes files with os.Lstat, so links are not followed.
+func (s *Service) ValidateNoSymlinkAssets(ctx context.Context, root string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var symlinks []string
+
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			symlinks = append(symlinks, p)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return symlinks, err
+	}
+
+	if len(symlinks) > 0 {
+		return symlinks, fmt.Errorf("%w: %s", ErrSymlink, strings.Join(symlinks, ", "))
+	}
+
+	return nil, nil
+}
+
+// ReportMissingChains returns coins known by go-primitives without a chain folder, sorted by coin ID.
+func (s *Service) ReportMissingChains(ctx context.Context) ([]coin.Coin, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var missing []coin.Coin
+	for _, c := range coin.Coins {
+		_, err := os.Stat(getChainPath(c.Handle))
+		if errors.Is(err, os.ErrNotExist) {
+			missing = append(missing, c)
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(missing, func(i, j int) bool {
+		return missing[i].ID < missing[j].ID
+	})
+
+	return missing, nil
+}
+
+// CrossValidateTokenListVsAssetDirs returns token list entries of the chain without an asset folder.
+// Native coin entries have no asset folder and are not returned.
+func (s *Service) CrossValidateTokenListVsAssetDirs(ctx context.Context, chainHandle string) ([]TokenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var tokenList TokenList
+	if err := fileLib.ReadJSONFile(path.GetTokenListPath(chainHandle), &tokenList); err != nil {
+		return nil, err
+	}
+
+	var missing []TokenItem
+	for _, token := range tokenList.Tokens {
+		if token.Type == types.Coin || token.Address == "" {
+			continue
+		}
+
+		if !fileLib.FileExists(path.GetAssetPath(chainHandle, token.Address)) {
+			missing = append(missing, token)
+		}
+	}
+
+	return missing, nil
+}
+
+func (s *Service) ValidateAssetInfoFile(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	file, err := os.Open(f.Path())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	buf := bytes.NewBuffer(nil)
+	if _, err = buf.ReadFrom(file); err != nil {
+		return err
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("%w: failed to seek reader", validation.ErrInvalidJson)
+	}
+
+	var payload info.AssetModel
+	err = json.Unmarshal(buf.Bytes(), &payload)
+	if err != nil {
+		return fmt.Errorf("%w: failed to decode", err)
+	}
+
+	err = info.ValidateAsset(payload, f.Chain(), f.Asset())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ValidateValidatorsListFile(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	file, err := os.Open(f.Path())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if !isStackingChain(f.Chain()) {
+		return nil
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if _, err = buf.ReadFrom(file); err != nil {
+		return err
+	}
+
+	var model []list.Model
+	err = json.Unmarshal(buf.Bytes(), &model)
+	if err != nil {
+		return err
+	}
+
+	err = list.ValidateList(model)
+	if err != nil {
+		return err
+	}
+
+	listIDs := make([]string, len(model))
+	for i, listItem := range model {
+		listIDs[i] = *listItem.ID
+	}
+
+	assetsPath := path.GetValidatorAssetsPath(f.Chain().Handle)
+	assetFolder := s.fileService.GetAssetFile(assetsPath)
+
+	file2, err := os.Open(assetFolder.Path())
+	if err != nil {
 		return err
 	}
 	defer file2.Close()
@@ -343,7 +1234,11 @@ func isStackingChain(c coin.Coin) bool {
 }
 
 // nolint:funlen
-func (s *Service) ValidateTokenListFile(f *file.AssetFile) error {
+func (s *Service) ValidateTokenListFile(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	file, err := os.Open(f.Path())
 	if err != nil {
 		return err
@@ -408,7 +1303,233 @@ func (s *Service) ValidateTokenListFile(f *file.AssetFile) error {
 	return nil
 }
 
-func (s *Service) ValidateInfoFolder(f *file.AssetFile) error {
+const (
+	// defaultTokenListSizeLimit is used for chains without a configured limit.
+	defaultTokenListSizeLimit = 10000
+
+	// defaultMaxTokenDropPercent is used when the allowed drop of token list size is not configured.
+	defaultMaxTokenDropPercent = 5
+
+	activeStatus = "active"
+
+	logoMinW = 64
+	logoMinH = 64
+
+	// logoAspectRatioTolerance allows small differences of square logos dimensions, in pixels.
+	logoAspectRatioTolerance = 5
+
+	// logoDPI is the resolution logos of the repository are saved with.
+	logoDPI = 96
+
+	// defaultMinLuminanceVariance is used when the minimum logo luminance variance is not configured.
+	defaultMinLuminanceVariance = 25
+
+	descriptionMinLength = 40
+
+	assetNameMinLength = 2
+	assetNameMaxLength = 50
+
+	assetSymbolMinLength = 1
+	assetSymbolMaxLength = 20
+)
+
+// allowedAssetStatuses also includes "abandoned", used by asset folder validation for assets without a logo.
+var allowedAssetStatuses = []string{activeStatus, "inactive", "spam", "abandoned"}
+
+var defaultDescriptionDenylist = []string{
+	"this is the official token of",
+	"lorem ipsum",
+	"token description",
+	"description goes here",
+}
+
+// ValidateTokenListSize checks that number of active tokens in the list doesn't exceed the limit of the chain.
+func (s *Service) ValidateTokenListSize(ctx context.Context, f *file.AssetFile, limits map[string]int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var model TokenList
+	if err := fileLib.ReadJSONFile(f.Path(), &model); err != nil {
+		return err
+	}
+
+	limit, ok := limits[f.Chain().Handle]
+	if !ok {
+		limit = defaultTokenListSizeLimit
+	}
+
+	var count int
+	for _, token := range model.Tokens {
+		if token.Type == types.Coin || assetStatus(f.Chain().Handle, token.Address) == activeStatus {
+			count++
+		}
+	}
+
+	if count > limit {
+		return fmt.Errorf("%w: token list has %d active tokens, limit for %s is %d",
+			validation.ErrInvalidField, count, f.Chain().Handle, limit)
+	}
+
+	return nil
+}
+
+//go:embed schema/tokenlist.schema.json
+var schemaFS embed.FS
+
+const tokenListSchemaPath = "schema/tokenlist.schema.json"
+
+// mustCompileTokenListSchema compiles the embedded token list JSON schema, it panics if the schema is invalid.
+func mustCompileTokenListSchema() *jsonschema.Schema {
+	schemaFile, err := schemaFS.Open(tokenListSchemaPath)
+	if err != nil {
+		panic(err)
+	}
+	defer schemaFile.Close()
+
+	compiler := jsonschema.NewCompiler()
+	if err = compiler.AddResource(tokenListSchemaPath, schemaFile); err != nil {
+		panic(err)
+	}
+
+	return compiler.MustCompile(tokenListSchemaPath)
+}
+
+// ValidateTokenListIntegrity validates the token list document against the embedded JSON schema of the token list
+// format of the repository. It is not the EIP-747 token list schema: token fields follow EIP-747, list fields and
+// the asset, type and pairs token fields are Trust Wallet extensions. Each violated constraint is returned as
+// SchemaError.
+func (s *Service) ValidateTokenListIntegrity(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(f.Path())
+	if err != nil {
+		return err
+	}
+
+	// Numbers are kept as json.Number, so decimals are not validated as floats.
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err = decoder.Decode(&document); err != nil {
+		return fmt.Errorf("%w: %s", validation.ErrInvalidJson, err)
+	}
+
+	err = s.tokenListSchema.Validate(document)
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	compErr := validation.NewErrComposite()
+	for _, leaf := range schemaErrorLeaves(validationErr) {
+		compErr.Append(SchemaError{
+			Path:       "/" + strings.TrimPrefix(leaf.InstanceLocation, "/"),
+			Constraint: leaf.KeywordLocation,
+			Message:    leaf.Message,
+		})
+	}
+
+	return compErr
+}
+
+// schemaErrorLeaves returns errors without causes, which are the violated constraints.
+func schemaErrorLeaves(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+
+	var leaves []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		leaves = append(leaves, schemaErrorLeaves(cause)...)
+	}
+
+	return leaves
+}
+
+// ValidateTokenItemTypes checks that token list items have the token type of the chain, e.g. ERC20 on Ethereum.
+// Native coin items and chains without a known token type are skipped.
+func (s *Service) ValidateTokenItemTypes(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var model TokenList
+	if err := fileLib.ReadJSONFile(f.Path(), &model); err != nil {
+		return err
+	}
+
+	compErr := validation.NewErrComposite()
+
+	for i, token := range model.Tokens {
+		if token.Type == types.Coin {
+			continue
+		}
+
+		expected, ok := types.GetTokenType(f.Chain().ID, token.Address)
+		if !ok || string(token.Type) == expected {
+			continue
+		}
+
+		compErr.Append(fmt.Errorf("%w: token #%d %s has type '%s', expected '%s'",
+			validation.ErrInvalidField, i, token.Address, token.Type, expected))
+	}
+
+	if compErr.Len() > 0 {
+		return compErr
+	}
+
+	return nil
+}
+
+var requiredTokenFields = []string{"address", "name", "symbol", "decimals", "logoURI"}
+
+func (s *Service) ValidateTokenListSchema(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var model struct {
+		Tokens []map[string]json.RawMessage `json:"tokens"`
+	}
+
+	err := fileLib.ReadJSONFile(f.Path(), &model)
+	if err != nil {
+		return err
+	}
+
+	compErr := validation.NewErrComposite()
+
+	for i, token := range model.Tokens {
+		for _, field := range requiredTokenFields {
+			if isEmptyJSONValue(token[field]) {
+				compErr.Append(ValidationError{Index: i, Field: field})
+			}
+		}
+	}
+
+	if compErr.Len() > 0 {
+		return compErr
+	}
+
+	return nil
+}
+
+func isEmptyJSONValue(value json.RawMessage) bool {
+	v := strings.TrimSpace(string(value))
+
+	return v == "" || v == "null" || v == `""`
+}
+
+func (s *Service) ValidateInfoFolder(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	file, err := os.Open(f.Path())
 	if err != nil {
 		return err
@@ -428,7 +1549,11 @@ func (s *Service) ValidateInfoFolder(f *file.AssetFile) error {
 	return nil
 }
 
-func (s *Service) ValidateValidatorsAssetFolder(f *file.AssetFile) error {
+func (s *Service) ValidateValidatorsAssetFolder(ctx context.Context, f *file.AssetFile) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	file, err := os.Open(f.Path())
 	if err != nil {
 		return err
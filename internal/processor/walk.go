@@ -0,0 +1,46 @@
+package processor
+
+import (
+	"fmt"
+	"os"
+)
+
+const chainsPath = "blockchains"
+
+// getChainHandles returns names of all chain folders.
+func getChainHandles() ([]string, error) {
+	return listDirs(chainsPath)
+}
+
+func getChainPath(chainHandle string) string {
+	return fmt.Sprintf("%s/%s", chainsPath, chainHandle)
+}
+
+func getChainInfoPath(chainHandle string) string {
+	return fmt.Sprintf("%s/info/info.json", getChainPath(chainHandle))
+}
+
+func getChainAssetsPath(chainHandle string) string {
+	return fmt.Sprintf("%s/assets", getChainPath(chainHandle))
+}
+
+// getChainAssetIDs returns names of asset folders of the chain.
+func getChainAssetIDs(chainHandle string) ([]string, error) {
+	return listDirs(getChainAssetsPath(chainHandle))
+}
+
+func listDirs(dirPath string) ([]string, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dir: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/trustwallet/assets-go-libs/validation"
+)
+
+const errCodeUnknown = "validation_failed"
+
+// errorCodes maps known validation errors to codes which are stable across error message changes.
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{validation.ErrMissingFile, "missing_file"},
+	{validation.ErrMissingField, "missing_field"},
+	{validation.ErrInvalidField, "invalid_field"},
+	{validation.ErrNotAllowedFile, "not_allowed_file"},
+	{validation.ErrInvalidAddress, "invalid_address"},
+	{validation.ErrInvalidJson, "invalid_json"},
+	{validation.ErrInvalidImgDimension, "invalid_image_dimension"},
+	{validation.ErrInvalidFileNameCase, "invalid_file_name_case"},
+	{validation.ErrInvalidFileExt, "invalid_file_extension"},
+	{validation.ErrInvalidFileSize, "invalid_file_size"},
+	{validation.ErrInvalidFileNameLength, "invalid_file_name_length"},
+}
+
+type (
+	ValidationReport struct {
+		TotalFiles  int         `json:"totalFiles"`
+		PassedFiles int         `json:"passedFiles"`
+		Errors      []FileError `json:"errors"`
+	}
+
+	FileError struct {
+		Path    string `json:"path"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+)
+
+func (r *ValidationReport) Summary() string {
+	return fmt.Sprintf("Total files: %d, passed: %d, errors: %d", r.TotalFiles, r.PassedFiles, len(r.Errors))
+}
+
+// ValidateAll runs validators for all known files and collects errors into the report.
+func (s *Service) ValidateAll(ctx context.Context) (*ValidationReport, error) {
+	report := &ValidationReport{Errors: make([]FileError, 0)}
+
+	for _, path := range s.fileService.GetPaths() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		f := s.fileService.GetAssetFile(path)
+		passed := true
+
+		for _, validator := range s.processorService.GetValidator(f) {
+			err := validator.Run(ctx, f)
+			if err == nil {
+				continue
+			}
+
+			passed = false
+
+			for _, e := range UnwrapComposite(err) {
+				report.Errors = append(report.Errors, FileError{
+					Path:    f.Path(),
+					Code:    errorCode(e),
+					Message: e.Error(),
+				})
+			}
+
+			if validator.StopOnError {
+				break
+			}
+		}
+
+		report.TotalFiles++
+		if passed {
+			report.PassedFiles++
+		}
+	}
+
+	return report, nil
+}
+
+func errorCode(err error) string {
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+
+	return errCodeUnknown
+}
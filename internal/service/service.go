@@ -1,6 +1,13 @@
 package service
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+
 	"github.com/trustwallet/assets-go-libs/validation"
 	"github.com/trustwallet/assets/internal/file"
 	"github.com/trustwallet/assets/internal/processor"
@@ -9,6 +16,8 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+const linkCheckTimeout = 10 * time.Second
+
 type Service struct {
 	fileService      *file.Service
 	processorService *processor.Service
@@ -23,47 +32,202 @@ func NewService(fs *file.Service, cs *processor.Service, rs *report.Service) *Se
 	}
 }
 
-func (s *Service) RunJob(paths []string, job func(*file.AssetFile)) {
+func (s *Service) RunJob(ctx context.Context, paths []string, job func(context.Context, *file.AssetFile)) error {
 	for _, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		f := s.fileService.GetAssetFile(path)
-		job(f)
+		job(ctx, f)
 		s.reportService.IncTotalFiles()
 	}
+
+	return nil
 }
 
-func (s *Service) Check(f *file.AssetFile) {
+func (s *Service) Check(ctx context.Context, f *file.AssetFile) {
 	validators := s.processorService.GetValidator(f)
 
 	for _, validator := range validators {
-		if err := validator.Run(f); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err := validator.Run(ctx, f); err != nil {
 			s.handleError(err, f, validator.Name)
+
+			if validator.StopOnError {
+				return
+			}
 		}
 	}
 }
 
-func (s *Service) Fix(f *file.AssetFile) {
+// CheckLinks validates that links of asset info files are reachable.
+func (s *Service) CheckLinks(ctx context.Context, f *file.AssetFile) {
+	if f.Type() != file.TypeAssetInfoFile {
+		return
+	}
+
+	if err := s.processorService.ValidateAssetInfoLinks(ctx, f, linkCheckTimeout); err != nil && ctx.Err() == nil {
+		s.handleError(err, f, "Asset info links are reachable")
+	}
+}
+
+func (s *Service) Fix(ctx context.Context, f *file.AssetFile) {
 	fixers := s.processorService.GetFixers(f)
 
 	for _, fixer := range fixers {
-		if err := fixer.Run(f); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		result, err := fixer.Run(ctx, f)
+		if err != nil {
 			s.handleError(err, f, fixer.Name)
+			continue
+		}
+
+		if result != nil {
+			logResult(result, fixer.Name)
+		}
+	}
+}
+
+// FixAllConcurrent runs fixers for all known files using a pool of workers.
+// Zero workers means one worker per CPU. Applied fixes are written to the changelog as markdown list items,
+// unless it is nil.
+func (s *Service) FixAllConcurrent(ctx context.Context, workers int, changelog io.Writer) error {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	// Folder fixers may rename directories, so they have to finish before the files inside are fixed.
+	var folders, files []string
+	for _, path := range s.fileService.GetPaths() {
+		if s.fileService.GetAssetFile(path).Type() == file.TypeAssetFolder {
+			folders = append(folders, path)
+		} else {
+			files = append(files, path)
+		}
+	}
+
+	s.fixConcurrent(ctx, folders, workers, changelog)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.fixConcurrent(ctx, files, workers, changelog)
+
+	return ctx.Err()
+}
+
+type fixFailure struct {
+	fixerName string
+	err       error
+}
+
+type fixResult struct {
+	file     *file.AssetFile
+	results  []*processor.Result
+	failures []fixFailure
+}
+
+func (s *Service) fixConcurrent(ctx context.Context, paths []string, workers int, changelog io.Writer) {
+	jobs := make(chan string)
+	results := make(chan fixResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for path := range jobs {
+				results <- s.runFixers(ctx, s.fileService.GetAssetFile(path))
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+
+		for _, path := range paths {
+			select {
+			case jobs <- path:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for result := range results {
+		for _, r := range result.results {
+			logResult(r, "")
+
+			if changelog == nil {
+				continue
+			}
+
+			if _, err := fmt.Fprintln(changelog, s.processorService.GenerateChangelogEntry(r)); err != nil {
+				log.WithError(err).WithField("path", r.Path).Warn("Failed to write changelog entry")
+			}
+		}
+
+		for _, failure := range result.failures {
+			s.handleError(failure.err, result.file, failure.fixerName)
+		}
+
+		s.reportService.IncTotalFiles()
+	}
+}
+
+func (s *Service) runFixers(ctx context.Context, f *file.AssetFile) fixResult {
+	result := fixResult{file: f}
+
+	for _, fixer := range s.processorService.GetFixers(f) {
+		if ctx.Err() != nil {
+			break
+		}
+
+		r, err := fixer.Run(ctx, f)
+		if err != nil {
+			result.failures = append(result.failures, fixFailure{fixerName: fixer.Name, err: err})
+			continue
+		}
+
+		if r != nil {
+			result.results = append(result.results, r)
 		}
 	}
+
+	return result
 }
 
-func (s *Service) RunUpdateAuto() {
+func (s *Service) RunUpdateAuto(ctx context.Context) {
 	updaters := s.processorService.GetUpdatersAuto()
-	s.runUpdaters(updaters)
+	s.runUpdaters(ctx, updaters)
 }
 
-func (s *Service) RunUpdateManual() {
+func (s *Service) RunUpdateManual(ctx context.Context) {
 	updaters := s.processorService.GetUpdatersManual()
-	s.runUpdaters(updaters)
+	s.runUpdaters(ctx, updaters)
 }
 
-func (s *Service) runUpdaters(updaters []processor.Updater) {
+func (s *Service) runUpdaters(ctx context.Context, updaters []processor.Updater) {
 	for _, updater := range updaters {
-		err := updater.Run()
+		if ctx.Err() != nil {
+			return
+		}
+
+		err := updater.Run(ctx)
 		if err != nil {
 			log.WithError(err).Error()
 		}
@@ -86,6 +250,16 @@ func (s *Service) handleError(err error, info *file.AssetFile, valName string) {
 	}
 }
 
+func logResult(result *processor.Result, fixerName string) {
+	log.WithFields(log.Fields{
+		"path":   result.Path,
+		"action": result.Action,
+		"before": result.Before,
+		"after":  result.After,
+		"fixer":  fixerName,
+	}).Debug("Applied fix")
+}
+
 func UnwrapComposite(err error) []error {
 	compErr, ok := err.(*validation.ErrComposite)
 	if !ok {
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const watchDebounce = 500 * time.Millisecond
+
+var watchSkipDirs = map[string]struct{}{
+	".git":         {},
+	"node_modules": {},
+}
+
+// WatchAndFix runs fixers for files under root whenever they are written. Consecutive writes of a file are
+// debounced, so fixers run once the file is saved. It blocks until the context is cancelled.
+func (s *Service) WatchAndFix(ctx context.Context, root string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err = watchDirs(watcher, root); err != nil {
+		return err
+	}
+
+	fixes := make(chan string)
+	timers := make(map[string]*time.Timer)
+
+	defer func() {
+		for _, timer := range timers {
+			timer.Stop()
+		}
+	}()
+
+	log.WithField("root", root).Info("Watching for file changes")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-watcher.Errors:
+			log.WithError(err).Error("Watcher error")
+		case event := <-watcher.Events:
+			s.handleWatchEvent(ctx, watcher, event, timers, fixes)
+		case path := <-fixes:
+			delete(timers, path)
+
+			if _, err = os.Stat(path); err != nil {
+				continue
+			}
+
+			log.WithField("path", path).Debug("Fixing changed file")
+
+			s.Fix(ctx, s.fileService.GetAssetFile(fmt.Sprintf("./%s", filepath.Clean(path))))
+		}
+	}
+}
+
+func (s *Service) handleWatchEvent(
+	ctx context.Context,
+	watcher *fsnotify.Watcher,
+	event fsnotify.Event,
+	timers map[string]*time.Timer,
+	fixes chan<- string,
+) {
+	if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+		return
+	}
+
+	if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+		if err = watchDirs(watcher, event.Name); err != nil {
+			log.WithError(err).Error("Failed to watch new dir")
+		}
+	}
+
+	if timer, ok := timers[event.Name]; ok {
+		timer.Reset(watchDebounce)
+
+		return
+	}
+
+	path := event.Name
+	timers[path] = time.AfterFunc(watchDebounce, func() {
+		select {
+		case fixes <- path:
+		case <-ctx.Done():
+		}
+	})
+}
+
+func watchDirs(watcher *fsnotify.Watcher, root string) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			return nil
+		}
+
+		if _, ok := watchSkipDirs[info.Name()]; ok {
+			return filepath.SkipDir
+		}
+
+		if err = watcher.Add(path); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", path, err)
+		}
+
+		return nil
+	})
+}